@@ -0,0 +1,92 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package errors
+
+import (
+	"net/http"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reasonCodes maps metav1.StatusReason to HTTP status code and gRPC code
+var reasonCodes = map[metav1.StatusReason]struct {
+	http int
+	grpc codes.Code
+}{
+	metav1.StatusReasonUnknown:            {http.StatusInternalServerError, codes.Unknown},
+	metav1.StatusReasonUnauthorized:       {http.StatusUnauthorized, codes.Unauthenticated},
+	metav1.StatusReasonForbidden:          {http.StatusForbidden, codes.PermissionDenied},
+	metav1.StatusReasonNotFound:           {http.StatusNotFound, codes.NotFound},
+	metav1.StatusReasonAlreadyExists:      {http.StatusConflict, codes.AlreadyExists},
+	metav1.StatusReasonConflict:           {http.StatusConflict, codes.Aborted},
+	metav1.StatusReasonGone:               {http.StatusGone, codes.NotFound},
+	metav1.StatusReasonInvalid:            {http.StatusUnprocessableEntity, codes.InvalidArgument},
+	metav1.StatusReasonTimeout:            {http.StatusGatewayTimeout, codes.DeadlineExceeded},
+	metav1.StatusReasonTooManyRequests:    {http.StatusTooManyRequests, codes.ResourceExhausted},
+	metav1.StatusReasonBadRequest:         {http.StatusBadRequest, codes.InvalidArgument},
+	metav1.StatusReasonMethodNotAllowed:   {http.StatusMethodNotAllowed, codes.Unimplemented},
+	metav1.StatusReasonInternalError:      {http.StatusInternalServerError, codes.Internal},
+	metav1.StatusReasonServiceUnavailable: {http.StatusServiceUnavailable, codes.Unavailable},
+}
+
+// HTTPStatusCode returns the HTTP status code of err, the code carried by metav1.Status
+// takes precedence over the one of metav1.StatusReason.
+func HTTPStatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	s, ok := statusOf(err)
+	if !ok {
+		return http.StatusInternalServerError
+	}
+	if s.Code != 0 {
+		return int(s.Code)
+	}
+	if c, ok := reasonCodes[s.Reason]; ok {
+		return c.http
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCCode returns the gRPC code of err
+func GRPCCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	s, ok := statusOf(err)
+	if !ok {
+		return codes.Unknown
+	}
+	if c, ok := reasonCodes[s.Reason]; ok {
+		return c.grpc
+	}
+	return codes.Unknown
+}
+
+// GRPCStatus implements the interface used by status.FromError, so that StatusError
+// can be returned by gRPC handlers directly.
+func (e *StatusError) GRPCStatus() *status.Status {
+	return status.New(GRPCCode(e), e.ErrStatus.Message)
+}
@@ -0,0 +1,290 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package errors provides the structured errors of API, which carry metav1.Status
+// and can be translated into HTTP status codes and gRPC codes.
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/validation/field"
+)
+
+// APIStatus is exposed by errors that can be converted to a metav1.Status
+type APIStatus interface {
+	Status() metav1.Status
+}
+
+var _ error = (*StatusError)(nil)
+
+// StatusError is an error intended for consumption by a REST API server, it can also be
+// reconstructed by clients from a REST response.
+type StatusError struct {
+	ErrStatus metav1.Status
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return e.ErrStatus.Message
+}
+
+// Status allows access to e's status without having to know the detailed workings
+// of StatusError.
+func (e *StatusError) Status() metav1.Status {
+	return e.ErrStatus
+}
+
+// FromStatus returns the StatusError of metav1.Status
+func FromStatus(status *metav1.Status) *StatusError {
+	out := &StatusError{}
+	status.DeepCopyInto(&out.ErrStatus)
+	return out
+}
+
+func newStatusError(code int32, reason metav1.StatusReason, message string, details *metav1.StatusDetails) *StatusError {
+	return &StatusError{metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", ApiVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Code:     code,
+		Reason:   reason,
+		Message:  message,
+		Details:  details,
+	}}
+}
+
+// NewNotFound returns a new error which indicates that the resource of the kind and the name was not found.
+func NewNotFound(qualifiedResource schema.GroupResource, name string) *StatusError {
+	return newStatusError(http.StatusNotFound, metav1.StatusReasonNotFound,
+		fmt.Sprintf("%s %q not found", qualifiedResource.String(), name),
+		&metav1.StatusDetails{Group: qualifiedResource.Group, Kind: qualifiedResource.Resource, Name: name})
+}
+
+// NewAlreadyExists returns an error indicating the item requested exists by that identifier.
+func NewAlreadyExists(qualifiedResource schema.GroupResource, name string) *StatusError {
+	return newStatusError(http.StatusConflict, metav1.StatusReasonAlreadyExists,
+		fmt.Sprintf("%s %q already exists", qualifiedResource.String(), name),
+		&metav1.StatusDetails{Group: qualifiedResource.Group, Kind: qualifiedResource.Resource, Name: name})
+}
+
+// NewConflict returns an error indicating the item can't be updated as provided.
+func NewConflict(qualifiedResource schema.GroupResource, name string, err error) *StatusError {
+	return newStatusError(http.StatusConflict, metav1.StatusReasonConflict,
+		fmt.Sprintf("operation cannot be fulfilled on %s %q: %v", qualifiedResource.String(), name, err),
+		&metav1.StatusDetails{Group: qualifiedResource.Group, Kind: qualifiedResource.Resource, Name: name})
+}
+
+// NewGone returns an error indicating the item no longer available at the server and no forwarding address is known.
+func NewGone(message string) *StatusError {
+	return newStatusError(http.StatusGone, metav1.StatusReasonGone, message, nil)
+}
+
+// NewInvalid returns an error indicating the item is invalid and cannot be processed.
+func NewInvalid(qualifiedKind schema.GroupKind, name string, errs field.ErrorList) *StatusError {
+	causes := make([]*metav1.StatusCause, 0, len(errs))
+	for i := range errs {
+		err := errs[i]
+		causes = append(causes, &metav1.StatusCause{
+			Type:    metav1.CauseType(err.Type),
+			Message: err.ErrorBody(),
+			Field:   err.Field,
+		})
+	}
+
+	message := fmt.Sprintf("%s %q is invalid", qualifiedKind.String(), name)
+	if len(errs) > 0 {
+		message = fmt.Sprintf("%s: %v", message, errs.Error())
+	}
+	return newStatusError(http.StatusUnprocessableEntity, metav1.StatusReasonInvalid, message,
+		&metav1.StatusDetails{Group: qualifiedKind.Group, Kind: qualifiedKind.Kind, Name: name, Causes: causes})
+}
+
+// NewBadRequest creates an error that indicates that the request is invalid and can not be processed.
+func NewBadRequest(reason string) *StatusError {
+	return newStatusError(http.StatusBadRequest, metav1.StatusReasonBadRequest, reason, nil)
+}
+
+// NewUnauthorized returns an error indicating the client is not authorized to perform the requested action.
+func NewUnauthorized(reason string) *StatusError {
+	message := reason
+	if len(message) == 0 {
+		message = "not authorized"
+	}
+	return newStatusError(http.StatusUnauthorized, metav1.StatusReasonUnauthorized, message, nil)
+}
+
+// NewForbidden returns an error indicating the requested action was forbidden
+func NewForbidden(qualifiedResource schema.GroupResource, name string, err error) *StatusError {
+	var message string
+	if qualifiedResource.Empty() {
+		message = fmt.Sprintf("forbidden: %v", err)
+	} else if name == "" {
+		message = fmt.Sprintf("%s is forbidden: %v", qualifiedResource.String(), err)
+	} else {
+		message = fmt.Sprintf("%s %q is forbidden: %v", qualifiedResource.String(), name, err)
+	}
+	return newStatusError(http.StatusForbidden, metav1.StatusReasonForbidden, message,
+		&metav1.StatusDetails{Group: qualifiedResource.Group, Kind: qualifiedResource.Resource, Name: name})
+}
+
+// NewMethodNotSupported returns an error indicating the requested action is not supported on this kind.
+func NewMethodNotSupported(qualifiedResource schema.GroupResource, action string) *StatusError {
+	return newStatusError(http.StatusMethodNotAllowed, metav1.StatusReasonMethodNotAllowed,
+		fmt.Sprintf("%s is not supported on resources of kind %q", action, qualifiedResource.String()),
+		&metav1.StatusDetails{Group: qualifiedResource.Group, Kind: qualifiedResource.Resource})
+}
+
+// NewTimeout returns an error indicating that a timeout occurred before the request could be completed.
+func NewTimeout(message string) *StatusError {
+	return newStatusError(http.StatusGatewayTimeout, metav1.StatusReasonTimeout, message, nil)
+}
+
+// NewTooManyRequests creates an error that indicates that the client must try again later because
+// the specified endpoint is not accepting requests.
+func NewTooManyRequests(message string) *StatusError {
+	return newStatusError(http.StatusTooManyRequests, metav1.StatusReasonTooManyRequests, message, nil)
+}
+
+// NewServiceUnavailable creates an error that indicates that the requested service is unavailable.
+func NewServiceUnavailable(reason string) *StatusError {
+	return newStatusError(http.StatusServiceUnavailable, metav1.StatusReasonServiceUnavailable, reason, nil)
+}
+
+// NewInternalError returns an error indicating that an unexpected error occurred in the server.
+func NewInternalError(err error) *StatusError {
+	return newStatusError(http.StatusInternalServerError, metav1.StatusReasonInternalError,
+		fmt.Sprintf("internal error occurred: %v", err),
+		&metav1.StatusDetails{Causes: []*metav1.StatusCause{{Message: err.Error()}}})
+}
+
+// IsNotFound returns true if the specified error was created by NewNotFound.
+func IsNotFound(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonNotFound
+}
+
+// IsAlreadyExists determines if the err is an error which indicates that a specified resource already exists.
+func IsAlreadyExists(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonAlreadyExists
+}
+
+// IsConflict determines if the err is an error which indicates the provided update conflicts.
+func IsConflict(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonConflict
+}
+
+// IsGone is true if the error indicates the requested resource is no longer available.
+func IsGone(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonGone
+}
+
+// IsInvalid determines if the err is an error which indicates the provided resource is not valid.
+func IsInvalid(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonInvalid
+}
+
+// IsBadRequest determines if err is an error which indicates that the request is invalid.
+func IsBadRequest(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonBadRequest
+}
+
+// IsUnauthorized determines if err is an error which indicates that the request is unauthorized and
+// requires authentication by the user.
+func IsUnauthorized(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonUnauthorized
+}
+
+// IsForbidden determines if err is an error which indicates that the request is forbidden and cannot
+// be completed as requested.
+func IsForbidden(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonForbidden
+}
+
+// IsMethodNotSupported determines if the err is an error which indicates the provided action could not
+// be performed because it is not supported by the server.
+func IsMethodNotSupported(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonMethodNotAllowed
+}
+
+// IsTimeout determines if err is an error which indicates that request times out due to long
+// processing.
+func IsTimeout(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonTimeout
+}
+
+// IsTooManyRequests determines if err is an error which indicates that there are too many requests
+// that the server cannot handle.
+func IsTooManyRequests(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonTooManyRequests
+}
+
+// IsServiceUnavailable is true if the error indicates the underlying service is no longer available.
+func IsServiceUnavailable(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonServiceUnavailable
+}
+
+// IsInternalError determines if err is an error which indicates an internal server error.
+func IsInternalError(err error) bool {
+	return ReasonForError(err) == metav1.StatusReasonInternalError
+}
+
+// ReasonForError returns the metav1.StatusReason for a particular error.
+func ReasonForError(err error) metav1.StatusReason {
+	if status, ok := statusOf(err); ok {
+		return status.Reason
+	}
+	return metav1.StatusReasonUnknown
+}
+
+// HasStatusCause returns true if the provided error has a details cause with the provided type name.
+func HasStatusCause(err error, name metav1.CauseType) bool {
+	status, ok := statusOf(err)
+	if !ok || status.Details == nil {
+		return false
+	}
+	for _, cause := range status.Details.Causes {
+		if cause.Type == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorToStatus converts any error to metav1.Status, the errors not carrying
+// metav1.Status are reported as internal errors.
+func ErrorToStatus(err error) metav1.Status {
+	if status, ok := statusOf(err); ok {
+		return status
+	}
+	return NewInternalError(err).Status()
+}
+
+func statusOf(err error) (metav1.Status, bool) {
+	var status APIStatus
+	if err != nil && errors.As(err, &status) {
+		return status.Status(), true
+	}
+	return metav1.Status{}, false
+}
@@ -0,0 +1,116 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage"
+	"github.com/vine-io/apimachinery/validation/field"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var gr = schema.GroupResource{Group: "test", Resource: "pods"}
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		is    func(error) bool
+		http  int
+		grpc  codes.Code
+		cause metav1.CauseType
+	}{
+		{name: "not found", err: NewNotFound(gr, "p1"), is: IsNotFound, http: http.StatusNotFound, grpc: codes.NotFound},
+		{name: "already exists", err: NewAlreadyExists(gr, "p1"), is: IsAlreadyExists, http: http.StatusConflict, grpc: codes.AlreadyExists},
+		{name: "conflict", err: NewConflict(gr, "p1", errors.New("stale")), is: IsConflict, http: http.StatusConflict, grpc: codes.Aborted},
+		{name: "gone", err: NewGone("gone"), is: IsGone, http: http.StatusGone, grpc: codes.NotFound},
+		{
+			name:  "invalid",
+			err:   NewInvalid(schema.GroupKind{Group: "test", Kind: "Pod"}, "p1", field.ErrorList{field.Required(field.NewPath("metadata", "name"), "")}),
+			is:    IsInvalid,
+			http:  http.StatusUnprocessableEntity,
+			grpc:  codes.InvalidArgument,
+			cause: metav1.CauseTypeFieldValueRequired,
+		},
+		{name: "bad request", err: NewBadRequest("bad"), is: IsBadRequest, http: http.StatusBadRequest, grpc: codes.InvalidArgument},
+		{name: "unauthorized", err: NewUnauthorized(""), is: IsUnauthorized, http: http.StatusUnauthorized, grpc: codes.Unauthenticated},
+		{name: "forbidden", err: NewForbidden(gr, "p1", errors.New("denied")), is: IsForbidden, http: http.StatusForbidden, grpc: codes.PermissionDenied},
+		{name: "method not supported", err: NewMethodNotSupported(gr, "patch"), is: IsMethodNotSupported, http: http.StatusMethodNotAllowed, grpc: codes.Unimplemented},
+		{name: "timeout", err: NewTimeout("timeout"), is: IsTimeout, http: http.StatusGatewayTimeout, grpc: codes.DeadlineExceeded},
+		{name: "too many requests", err: NewTooManyRequests("slow down"), is: IsTooManyRequests, http: http.StatusTooManyRequests, grpc: codes.ResourceExhausted},
+		{name: "service unavailable", err: NewServiceUnavailable("down"), is: IsServiceUnavailable, http: http.StatusServiceUnavailable, grpc: codes.Unavailable},
+		{name: "internal", err: NewInternalError(errors.New("boom")), is: IsInternalError, http: http.StatusInternalServerError, grpc: codes.Internal},
+		{name: "wrapped", err: fmt.Errorf("get: %w", NewNotFound(gr, "p1")), is: IsNotFound, http: http.StatusNotFound, grpc: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.is(tt.err) {
+				t.Fatalf("predicate of %v = false", tt.err)
+			}
+			if code := HTTPStatusCode(tt.err); code != tt.http {
+				t.Fatalf("HTTPStatusCode() = %d, want %d", code, tt.http)
+			}
+			if code := GRPCCode(tt.err); code != tt.grpc {
+				t.Fatalf("GRPCCode() = %v, want %v", code, tt.grpc)
+			}
+			if s, ok := status.FromError(tt.err); !ok || s.Code() != tt.grpc {
+				t.Fatalf("status.FromError() = %v, %v", s, ok)
+			}
+			if tt.cause != "" && !HasStatusCause(tt.err, tt.cause) {
+				t.Fatalf("expected cause %s in %v", tt.cause, ErrorToStatus(tt.err).Details)
+			}
+		})
+	}
+}
+
+func TestNonStatusError(t *testing.T) {
+	err := errors.New("raw")
+	if ReasonForError(err) != metav1.StatusReasonUnknown {
+		t.Fatalf("ReasonForError() = %v", ReasonForError(err))
+	}
+	if HTTPStatusCode(err) != http.StatusInternalServerError || GRPCCode(err) != codes.Unknown {
+		t.Fatalf("unexpected codes %d %v", HTTPStatusCode(err), GRPCCode(err))
+	}
+	if s := ErrorToStatus(err); s.Reason != metav1.StatusReasonInternalError || s.Status != metav1.StatusFailure {
+		t.Fatalf("ErrorToStatus() = %v", s)
+	}
+	if HTTPStatusCode(nil) != http.StatusOK || GRPCCode(nil) != codes.OK {
+		t.Fatal("nil error must be OK")
+	}
+}
+
+func TestFromStorageError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		is   func(error) bool
+	}{
+		{name: "record not found", err: gorm.ErrRecordNotFound, is: IsNotFound},
+		{name: "wrapped record not found", err: fmt.Errorf("find: %w", gorm.ErrRecordNotFound), is: IsNotFound},
+		{name: "duplicated key", err: gorm.ErrDuplicatedKey, is: IsAlreadyExists},
+		{name: "sqlite", err: errors.New("UNIQUE constraint failed: pods.name"), is: IsAlreadyExists},
+		{name: "mysql", err: errors.New("Error 1062 (23000): Duplicate entry 'p1' for key 'name'"), is: IsAlreadyExists},
+		{name: "postgres", err: errors.New(`ERROR: duplicate key value violates unique constraint "pods_pkey" (SQLSTATE 23505)`), is: IsAlreadyExists},
+		{name: "resource version conflict", err: storage.ErrConflict, is: IsConflict},
+		{name: "status error", err: NewConflict(gr, "p1", errors.New("stale")), is: IsConflict},
+		{name: "others", err: errors.New("connection refused"), is: IsInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := FromStorageError(tt.err, gr, "p1")
+			if !tt.is(err) {
+				t.Fatalf("FromStorageError() = %v", err)
+			}
+		})
+	}
+
+	if FromStorageError(nil, gr, "p1") != nil {
+		t.Fatal("FromStorageError(nil) != nil")
+	}
+}
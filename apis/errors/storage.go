@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package errors
+
+import (
+	"errors"
+
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage"
+	"gorm.io/gorm"
+)
+
+// IsUniqueViolation checks whether err is raised by a unique constraint violation,
+// both gorm.ErrDuplicatedKey (gorm.Config.TranslateError) and the raw driver errors are recognized.
+func IsUniqueViolation(err error) bool {
+	return storage.IsUniqueViolation(err)
+}
+
+// FromStorageError translates the error returned by storage into StatusError:
+//
+//	gorm.ErrRecordNotFound   -> NotFound
+//	unique constraint errors,
+//	storage.ErrAlreadyExists -> AlreadyExists
+//	storage.ErrConflict      -> Conflict
+//	others                   -> InternalError
+//
+// nil and the errors already carrying metav1.Status are returned as is.
+func FromStorageError(err error, qualifiedResource schema.GroupResource, name string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := statusOf(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return NewNotFound(qualifiedResource, name)
+	case IsUniqueViolation(err):
+		return NewAlreadyExists(qualifiedResource, name)
+	case errors.Is(err, storage.ErrConflict):
+		return NewConflict(qualifiedResource, name, err)
+	default:
+		return NewInternalError(err)
+	}
+}
@@ -0,0 +1,123 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	"time"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+// SetCondition sets the corresponding condition in conditions to newCondition and returns true
+// if the conditions are changed by this call.
+// conditions must be non-nil.
+//  1. if the condition of the specified type already exists (all fields of the existing condition are updated to
+//     newCondition, LastTransitionTime is set to now if the new status differs from the old status)
+//  2. if a condition of the specified type does not exist (LastTransitionTime is set to now() if unset, and newCondition is appended)
+func SetCondition(conditions *[]*metav1.Condition, newCondition metav1.Condition) (changed bool) {
+	if conditions == nil {
+		return false
+	}
+
+	existing := FindCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		if newCondition.LastTransitionTime == 0 {
+			newCondition.LastTransitionTime = time.Now().Unix()
+		}
+		*conditions = append(*conditions, &newCondition)
+		return true
+	}
+
+	if existing.Status != newCondition.Status {
+		existing.Status = newCondition.Status
+		if newCondition.LastTransitionTime != 0 {
+			existing.LastTransitionTime = newCondition.LastTransitionTime
+		} else {
+			existing.LastTransitionTime = time.Now().Unix()
+		}
+		changed = true
+	}
+
+	if existing.Reason != newCondition.Reason {
+		existing.Reason = newCondition.Reason
+		changed = true
+	}
+	if existing.Message != newCondition.Message {
+		existing.Message = newCondition.Message
+		changed = true
+	}
+	if existing.ObservedGeneration != newCondition.ObservedGeneration {
+		existing.ObservedGeneration = newCondition.ObservedGeneration
+		changed = true
+	}
+
+	return changed
+}
+
+// RemoveCondition removes the corresponding conditionType from conditions if present. Returns
+// true if it was present and got removed.
+// conditions must be non-nil.
+func RemoveCondition(conditions *[]*metav1.Condition, conditionType string) (removed bool) {
+	if conditions == nil || len(*conditions) == 0 {
+		return false
+	}
+
+	newConditions := make([]*metav1.Condition, 0, len(*conditions)-1)
+	for _, condition := range *conditions {
+		if condition.Type != conditionType {
+			newConditions = append(newConditions, condition)
+		}
+	}
+
+	removed = len(*conditions) != len(newConditions)
+	*conditions = newConditions
+
+	return removed
+}
+
+// FindCondition finds the conditionType in conditions.
+func FindCondition(conditions []*metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i] != nil && conditions[i].Type == conditionType {
+			return conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// IsConditionTrue returns true when the conditionType is present and set to `metav1.ConditionTrue`
+func IsConditionTrue(conditions []*metav1.Condition, conditionType string) bool {
+	return IsConditionPresentAndEqual(conditions, conditionType, metav1.ConditionTrue)
+}
+
+// IsConditionFalse returns true when the conditionType is present and set to `metav1.ConditionFalse`
+func IsConditionFalse(conditions []*metav1.Condition, conditionType string) bool {
+	return IsConditionPresentAndEqual(conditions, conditionType, metav1.ConditionFalse)
+}
+
+// IsConditionPresentAndEqual returns true when conditionType is present and equal to status.
+func IsConditionPresentAndEqual(conditions []*metav1.Condition, conditionType string, status metav1.ConditionStatus) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == status
+}
@@ -0,0 +1,155 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []*metav1.Condition
+		toAdd      metav1.Condition
+		changed    bool
+		expected   []*metav1.Condition
+	}{
+		{
+			name: "should-add",
+			conditions: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "third"},
+			},
+			toAdd:   metav1.Condition{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, Reason: "reason", Message: "message"},
+			changed: true,
+			expected: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "third"},
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, Reason: "reason", Message: "message"},
+			},
+		},
+		{
+			name: "use-supplied-time",
+			conditions: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "second", Status: metav1.ConditionFalse, LastTransitionTime: 5},
+			},
+			toAdd:   metav1.Condition{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, Reason: "reason", Message: "message"},
+			changed: true,
+			expected: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, Reason: "reason", Message: "message"},
+			},
+		},
+		{
+			name: "update-fields",
+			conditions: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 5},
+			},
+			toAdd:   metav1.Condition{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, ObservedGeneration: 3, Reason: "reason", Message: "message"},
+			changed: true,
+			expected: []*metav1.Condition{
+				{Type: "first"},
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 5, ObservedGeneration: 3, Reason: "reason", Message: "message"},
+			},
+		},
+		{
+			name: "unchanged",
+			conditions: []*metav1.Condition{
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 5, Reason: "reason"},
+			},
+			toAdd:   metav1.Condition{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 10, Reason: "reason"},
+			changed: false,
+			expected: []*metav1.Condition{
+				{Type: "second", Status: metav1.ConditionTrue, LastTransitionTime: 5, Reason: "reason"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := SetCondition(&tt.conditions, tt.toAdd)
+			if changed != tt.changed {
+				t.Errorf("SetCondition() = %v, want %v", changed, tt.changed)
+			}
+			if !reflect.DeepEqual(tt.conditions, tt.expected) {
+				t.Errorf("conditions = %v, want %v", tt.conditions, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetConditionDefaultTime(t *testing.T) {
+	var conditions []*metav1.Condition
+	SetCondition(&conditions, metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse})
+	if conditions[0].LastTransitionTime == 0 {
+		t.Fatal("LastTransitionTime is not set")
+	}
+
+	conditions[0].LastTransitionTime = 1
+	SetCondition(&conditions, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue})
+	if conditions[0].LastTransitionTime <= 1 {
+		t.Fatalf("LastTransitionTime = %d, expected to be bumped", conditions[0].LastTransitionTime)
+	}
+	if !IsConditionTrue(conditions, "Ready") || IsConditionFalse(conditions, "Ready") {
+		t.Fatalf("unexpected status %v", conditions[0].Status)
+	}
+}
+
+func TestRemoveCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditions    []*metav1.Condition
+		conditionType string
+		removed       bool
+		expected      []*metav1.Condition
+	}{
+		{
+			name:          "present",
+			conditions:    []*metav1.Condition{{Type: "first"}, {Type: "second"}, {Type: "third"}},
+			conditionType: "second",
+			removed:       true,
+			expected:      []*metav1.Condition{{Type: "first"}, {Type: "third"}},
+		},
+		{
+			name:          "not-present",
+			conditions:    []*metav1.Condition{{Type: "first"}, {Type: "third"}},
+			conditionType: "second",
+			expected:      []*metav1.Condition{{Type: "first"}, {Type: "third"}},
+		},
+		{
+			name:          "empty",
+			conditions:    []*metav1.Condition{},
+			conditionType: "second",
+			expected:      []*metav1.Condition{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			removed := RemoveCondition(&tt.conditions, tt.conditionType)
+			if removed != tt.removed {
+				t.Errorf("RemoveCondition() = %v, want %v", removed, tt.removed)
+			}
+			if !reflect.DeepEqual(tt.conditions, tt.expected) {
+				t.Errorf("conditions = %v, want %v", tt.conditions, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindCondition(t *testing.T) {
+	conditions := []*metav1.Condition{{Type: "first", Status: metav1.ConditionFalse}, {Type: "second", Status: metav1.ConditionUnknown}}
+
+	if c := FindCondition(conditions, "second"); c == nil || c.Status != metav1.ConditionUnknown {
+		t.Fatalf("FindCondition() = %v", c)
+	}
+	if c := FindCondition(conditions, "third"); c != nil {
+		t.Fatalf("FindCondition() = %v, want nil", c)
+	}
+	if IsConditionTrue(conditions, "first") || !IsConditionFalse(conditions, "first") || IsConditionTrue(conditions, "third") {
+		t.Fatal("unexpected condition status")
+	}
+}
@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+// ContainsFinalizer checks whether the object has the given finalizer
+func ContainsFinalizer(o metav1.Meta, finalizer string) bool {
+	for _, f := range o.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFinalizer adds the finalizer to the object, returns true if the finalizers are changed
+func AddFinalizer(o metav1.Meta, finalizer string) (changed bool) {
+	if ContainsFinalizer(o, finalizer) {
+		return false
+	}
+	o.SetFinalizers(append(o.GetFinalizers(), finalizer))
+	return true
+}
+
+// RemoveFinalizer removes the finalizer from the object, returns true if the finalizers are changed
+func RemoveFinalizer(o metav1.Meta, finalizer string) (changed bool) {
+	finalizers := o.GetFinalizers()
+	out := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f == finalizer {
+			changed = true
+			continue
+		}
+		out = append(out, f)
+	}
+	if changed {
+		o.SetFinalizers(out)
+	}
+	return changed
+}
+
+// IsBeingDeleted checks whether the object is marked for deletion and waits for its finalizers
+func IsBeingDeleted(o metav1.Meta) bool {
+	return o.GetDeletionTimestamp() != 0
+}
@@ -0,0 +1,40 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+func TestFinalizers(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+
+	if !AddFinalizer(o, "a") || !AddFinalizer(o, "b") {
+		t.Fatal("AddFinalizer() = false")
+	}
+	if AddFinalizer(o, "a") {
+		t.Fatal("AddFinalizer() of existing finalizer = true")
+	}
+	if !ContainsFinalizer(o, "b") || ContainsFinalizer(o, "c") {
+		t.Fatalf("unexpected finalizers %v", o.Finalizers)
+	}
+
+	if RemoveFinalizer(o, "c") {
+		t.Fatal("RemoveFinalizer() of missing finalizer = true")
+	}
+	if !RemoveFinalizer(o, "a") {
+		t.Fatal("RemoveFinalizer() = false")
+	}
+	if !reflect.DeepEqual(o.GetFinalizers(), []string{"b"}) {
+		t.Fatalf("GetFinalizers() = %v", o.GetFinalizers())
+	}
+
+	if IsBeingDeleted(o) {
+		t.Fatal("IsBeingDeleted() = true")
+	}
+	o.SetDeletionTimestamp(1)
+	if !IsBeingDeleted(o) {
+		t.Fatal("IsBeingDeleted() = false")
+	}
+}
@@ -0,0 +1,129 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+// ErrNoObservedGeneration is returned when the status of object has no ObservedGeneration field
+var ErrNoObservedGeneration = fmt.Errorf("object does not have status.observedGeneration")
+
+// The generation of object (metadata.generation) is increased by storage when the fields except
+// metadata and status are changed. By convention, the status of object records the generation
+// processed by reconciler in the int64 field ObservedGeneration (status.observedGeneration), the
+// latest spec is processed when the ObservedGeneration is equal to the generation.
+
+// ObservedGeneration returns status.observedGeneration of the object, the object is runtime.Unstructured
+// or a struct pointer with the field Status (by value or by pointer) holding ObservedGeneration.
+func ObservedGeneration(obj any) (int64, error) {
+	if u, ok := obj.(*runtime.Unstructured); ok {
+		v, _ := runtime.NestedField(u.Object, "status", "observedGeneration")
+		switch n := v.(type) {
+		case int64:
+			return n, nil
+		case int:
+			return int64(n), nil
+		case float64:
+			return int64(n), nil
+		}
+		return 0, nil
+	}
+
+	v, ok := observedGenerationField(obj, false)
+	if !ok {
+		return 0, fmt.Errorf("%w: %T", ErrNoObservedGeneration, obj)
+	}
+	if !v.IsValid() {
+		return 0, nil
+	}
+	return v.Int(), nil
+}
+
+// SetObservedGeneration sets status.observedGeneration of the object, the nil Status pointer is allocated.
+func SetObservedGeneration(obj any, generation int64) error {
+	if u, ok := obj.(*runtime.Unstructured); ok {
+		return runtime.SetNestedField(u.Object, generation, "status", "observedGeneration")
+	}
+
+	v, ok := observedGenerationField(obj, true)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNoObservedGeneration, obj)
+	}
+	v.SetInt(generation)
+	return nil
+}
+
+// IsGenerationObserved checks whether the latest generation of object is processed,
+// false is returned when the object has no metadata or status.observedGeneration.
+func IsGenerationObserved(obj any) bool {
+	m, err := Accessor(obj)
+	if err != nil {
+		return false
+	}
+	observed, err := ObservedGeneration(obj)
+	if err != nil {
+		return false
+	}
+	return observed >= m.GetGeneration()
+}
+
+// observedGenerationField returns Status.ObservedGeneration of the struct pointer. The invalid
+// reflect.Value is returned with true if the Status pointer is nil and alloc is false.
+func observedGenerationField(obj any, alloc bool) (reflect.Value, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	sf, ok := v.Elem().Type().FieldByName("Status")
+	if !ok || !sf.IsExported() {
+		return reflect.Value{}, false
+	}
+	st := sf.Type
+	if st.Kind() == reflect.Pointer {
+		st = st.Elem()
+	}
+	if st.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	field, ok := st.FieldByName("ObservedGeneration")
+	if !ok || !field.IsExported() || field.Type.Kind() != reflect.Int64 {
+		return reflect.Value{}, false
+	}
+
+	status := v.Elem().FieldByIndex(sf.Index)
+	if status.Kind() == reflect.Pointer {
+		if status.IsNil() {
+			if !alloc {
+				return reflect.Value{}, true
+			}
+			status.Set(reflect.New(st))
+		}
+		status = status.Elem()
+	}
+	return status.FieldByIndex(field.Index), true
+}
@@ -0,0 +1,68 @@
+package meta
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+type generationStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+type valueStatusObj struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Status            generationStatus `json:"status"`
+}
+
+type pointerStatusObj struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Status            *generationStatus `json:"status"`
+}
+
+func TestObservedGeneration(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  any
+	}{
+		{name: "status by value", obj: &valueStatusObj{ObjectMeta: metav1.ObjectMeta{Generation: 2}}},
+		{name: "status by pointer", obj: &pointerStatusObj{ObjectMeta: metav1.ObjectMeta{Generation: 2}}},
+		{name: "unstructured", obj: &runtime.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"generation": int64(2)},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if g, err := ObservedGeneration(tt.obj); err != nil || g != 0 {
+				t.Fatalf("ObservedGeneration() = %d, %v", g, err)
+			}
+			if IsGenerationObserved(tt.obj) {
+				t.Fatal("generation 2 should not be observed")
+			}
+
+			if err := SetObservedGeneration(tt.obj, 2); err != nil {
+				t.Fatal(err)
+			}
+			if g, err := ObservedGeneration(tt.obj); err != nil || g != 2 {
+				t.Fatalf("ObservedGeneration() = %d, %v", g, err)
+			}
+			if !IsGenerationObserved(tt.obj) {
+				t.Fatal("generation 2 should be observed")
+			}
+		})
+	}
+
+	for _, obj := range []any{&namedMetaObj{}, &nonMetaObj{}, valueStatusObj{}} {
+		if _, err := ObservedGeneration(obj); !errors.Is(err, ErrNoObservedGeneration) {
+			t.Fatalf("ObservedGeneration(%T) = %v, want ErrNoObservedGeneration", obj, err)
+		}
+		if err := SetObservedGeneration(obj, 1); !errors.Is(err, ErrNoObservedGeneration) {
+			t.Fatalf("SetObservedGeneration(%T) = %v, want ErrNoObservedGeneration", obj, err)
+		}
+		if IsGenerationObserved(obj) {
+			t.Fatalf("IsGenerationObserved(%T) should be false", obj)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+var (
+	ErrNotList = fmt.Errorf("object is not a list")
+)
+
+// IsListType returns true if the provided Object has a slice called Items.
+func IsListType(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *runtime.UnstructuredList:
+		return true
+	}
+
+	_, err := GetItemsPtr(obj)
+	return err == nil
+}
+
+// GetItemsPtr returns a pointer to the list object's Items member.
+func GetItemsPtr(list runtime.Object) (any, error) {
+	v, err := getItemsPtr(list)
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+func getItemsPtr(list runtime.Object) (reflect.Value, error) {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return reflect.Value{}, runtime.ErrIsNotPointer
+	}
+
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%w: %T", ErrNotList, list)
+	}
+	items := v.FieldByName("Items")
+	if !items.IsValid() || items.Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("%w: %T has no Items slice", ErrNotList, list)
+	}
+	return items.Addr(), nil
+}
+
+// EachListItem invokes fn on each runtime.Object in the list. Any error immediately terminates the loop.
+func EachListItem(obj runtime.Object, fn func(runtime.Object) error) error {
+	if ul, ok := obj.(*runtime.UnstructuredList); ok {
+		for i := range ul.Items {
+			if err := fn(&ul.Items[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ptr, err := getItemsPtr(obj)
+	if err != nil {
+		return err
+	}
+	items := ptr.Elem()
+	for i := 0; i < items.Len(); i++ {
+		item, err := itemObject(items.Index(i))
+		if err != nil {
+			return fmt.Errorf("items[%d]: %w", i, err)
+		}
+		if item == nil {
+			continue
+		}
+		if err = fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractList returns obj's Items element as an array of runtime.Objects. The elements
+// which are stored by value are returned as pointers into the list.
+func ExtractList(obj runtime.Object) ([]runtime.Object, error) {
+	list := make([]runtime.Object, 0)
+	err := EachListItem(obj, func(item runtime.Object) error {
+		list = append(list, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetList sets the given list object's Items member to have the elements given in objects.
+func SetList(list runtime.Object, objects []runtime.Object) error {
+	if ul, ok := list.(*runtime.UnstructuredList); ok {
+		items := make([]runtime.Unstructured, 0, len(objects))
+		for i, obj := range objects {
+			u, ok := obj.(*runtime.Unstructured)
+			if !ok {
+				return fmt.Errorf("objects[%d]: %T is not *runtime.Unstructured", i, obj)
+			}
+			items = append(items, *u)
+		}
+		ul.Items = items
+		return nil
+	}
+
+	ptr, err := getItemsPtr(list)
+	if err != nil {
+		return err
+	}
+	items := ptr.Elem()
+	slice := reflect.MakeSlice(items.Type(), len(objects), len(objects))
+	for i, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		dest := slice.Index(i)
+		src := reflect.ValueOf(obj)
+		switch {
+		case src.Type().AssignableTo(dest.Type()):
+			dest.Set(src)
+		case src.Kind() == reflect.Ptr && src.Elem().Type().AssignableTo(dest.Type()):
+			dest.Set(src.Elem())
+		default:
+			return fmt.Errorf("objects[%d]: can't assign %v into %v", i, src.Type(), dest.Type())
+		}
+	}
+	items.Set(slice)
+	return nil
+}
+
+// LenList returns the length of this list or 0 if it is not a list.
+func LenList(list runtime.Object) int {
+	if ul, ok := list.(*runtime.UnstructuredList); ok {
+		return len(ul.Items)
+	}
+
+	ptr, err := getItemsPtr(list)
+	if err != nil {
+		return 0
+	}
+	return ptr.Elem().Len()
+}
+
+func itemObject(v reflect.Value) (runtime.Object, error) {
+	switch {
+	case v.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if obj, ok := v.Interface().(runtime.Object); ok {
+			return obj, nil
+		}
+	case v.Kind() == reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if obj, ok := v.Interface().(runtime.Object); ok {
+			return obj, nil
+		}
+	case v.CanAddr():
+		if obj, ok := v.Addr().Interface().(runtime.Object); ok {
+			return obj, nil
+		}
+	}
+	return nil, fmt.Errorf("%v does not implement runtime.Object", v.Type())
+}
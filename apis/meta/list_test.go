@@ -0,0 +1,135 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+var gv = schema.GroupVersion{Group: "test", Version: "v1"}
+
+type TestObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+}
+
+func (t *TestObj) DeepCopyObject() runtime.Object {
+	out := new(TestObj)
+	*out = *t
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return out
+}
+
+func (t *TestObj) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestObj)
+}
+
+type TestObjList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []*TestObj `json:"items"`
+}
+
+func (t *TestObjList) DeepCopyObject() runtime.Object {
+	out := new(TestObjList)
+	*out = *t
+	out.Items = make([]*TestObj, len(t.Items))
+	for i := range t.Items {
+		out.Items[i] = t.Items[i].DeepCopyObject().(*TestObj)
+	}
+	return out
+}
+
+func (t *TestObjList) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestObjList)
+}
+
+type TestValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	Items           []TestObj `json:"items"`
+}
+
+func (t *TestValueList) DeepCopyObject() runtime.Object {
+	out := new(TestValueList)
+	*out = *t
+	return out
+}
+
+func (t *TestValueList) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestValueList)
+}
+
+func TestNewList(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestObjList{}, &TestObj{}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := scheme.NewList(gv.WithKind("TestObj"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := list.(*TestObjList); !ok {
+		t.Fatalf("NewList() = %T", list)
+	}
+	if list.GetObjectKind().GroupVersionKind() != gv.WithKind("TestObjList") {
+		t.Fatalf("unexpected gvk %v", list.GetObjectKind().GroupVersionKind())
+	}
+
+	if _, err = scheme.NewList(gv.WithKind("TestObjList")); err == nil {
+		t.Fatal("expected error for kind without list")
+	}
+}
+
+func TestSetList(t *testing.T) {
+	tests := []struct {
+		name string
+		list runtime.Object
+	}{
+		{name: "pointer items", list: &TestObjList{}},
+		{name: "value items", list: &TestValueList{}},
+		{name: "unstructured", list: &runtime.UnstructuredList{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []runtime.Object
+			for _, name := range []string{"a", "b"} {
+				if _, ok := tt.list.(*runtime.UnstructuredList); ok {
+					u := &runtime.Unstructured{}
+					u.SetName(name)
+					objects = append(objects, u)
+				} else {
+					objects = append(objects, &TestObj{ObjectMeta: metav1.ObjectMeta{Name: name}})
+				}
+			}
+
+			if !IsListType(tt.list) {
+				t.Fatal("IsListType() = false")
+			}
+			if err := SetList(tt.list, objects); err != nil {
+				t.Fatal(err)
+			}
+			if LenList(tt.list) != 2 {
+				t.Fatalf("LenList() = %d", LenList(tt.list))
+			}
+
+			items, err := ExtractList(tt.list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(items, objects) {
+				t.Fatalf("ExtractList() = %v, want %v", items, objects)
+			}
+		})
+	}
+
+	if IsListType(&TestObj{}) {
+		t.Fatal("TestObj is not a list")
+	}
+	if err := SetList(&TestValueList{}, []runtime.Object{&runtime.Unstructured{}}); err == nil {
+		t.Fatal("expected type mismatch error")
+	}
+}
@@ -0,0 +1,242 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+var (
+	ErrNoMeta     = fmt.Errorf("object does not implement the metav1.Meta interface")
+	ErrNoListMeta = fmt.Errorf("object does not implement the metav1.Lister interface")
+	ErrNoTypeMeta = fmt.Errorf("object does not have metav1.TypeMeta")
+)
+
+// Type exposes the type and APIVersion of API objects.
+type Type interface {
+	GetAPIVersion() string
+	SetAPIVersion(version string)
+	GetKind() string
+	SetKind(kind string)
+}
+
+var (
+	metaType     = reflect.TypeOf((*metav1.Meta)(nil)).Elem()
+	listerType   = reflect.TypeOf((*metav1.Lister)(nil)).Elem()
+	typeMetaType = reflect.TypeOf(metav1.TypeMeta{})
+)
+
+// Accessor takes an arbitrary object pointer and returns metav1.Meta. The object implementing
+// metav1.Meta is returned directly, otherwise the field implementing metav1.Meta (embedded by value,
+// by pointer or held by a named field, e.g. EntityMeta under "Metadata") is discovered by reflection,
+// and the path of the field is cached per type. ErrNoMeta is returned when the metadata is held by
+// a nil pointer, the object is never changed by Accessor.
+func Accessor(obj any) (metav1.Meta, error) {
+	if m, ok := obj.(metav1.Meta); ok {
+		return m, nil
+	}
+
+	v, ok := fieldByInterface(obj, metaType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNoMeta, obj)
+	}
+	return v.Interface().(metav1.Meta), nil
+}
+
+// ListAccessor returns metav1.Lister of the list object, it works as Accessor.
+func ListAccessor(obj any) (metav1.Lister, error) {
+	if m, ok := obj.(metav1.Lister); ok {
+		return m, nil
+	}
+
+	v, ok := fieldByInterface(obj, listerType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNoListMeta, obj)
+	}
+	return v.Interface().(metav1.Lister), nil
+}
+
+// TypeAccessor returns Type of the object. The object implementing Type (e.g. runtime.Unstructured)
+// is returned directly, otherwise the embedded metav1.TypeMeta or the schema.ObjectKind of runtime.Object is used.
+func TypeAccessor(obj any) (Type, error) {
+	if t, ok := obj.(Type); ok {
+		return t, nil
+	}
+
+	if v, ok := fieldByType(obj, typeMetaType); ok {
+		return typeMetaAccessor{v.Interface().(*metav1.TypeMeta)}, nil
+	}
+	if o, ok := obj.(runtime.Object); ok {
+		return objectKindAccessor{o.GetObjectKind()}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrNoTypeMeta, obj)
+}
+
+type typeMetaAccessor struct {
+	*metav1.TypeMeta
+}
+
+func (a typeMetaAccessor) GetAPIVersion() string { return a.ApiVersion }
+
+func (a typeMetaAccessor) SetAPIVersion(version string) { a.ApiVersion = version }
+
+func (a typeMetaAccessor) GetKind() string { return a.Kind }
+
+func (a typeMetaAccessor) SetKind(kind string) { a.Kind = kind }
+
+type objectKindAccessor struct {
+	kind schema.ObjectKind
+}
+
+func (a objectKindAccessor) GetAPIVersion() string {
+	return a.kind.GroupVersionKind().APIGroup()
+}
+
+func (a objectKindAccessor) SetAPIVersion(version string) {
+	gv, err := schema.ParseGroupVersion(version)
+	if err != nil {
+		return
+	}
+	a.kind.SetGroupVersionKind(gv.WithKind(a.GetKind()))
+}
+
+func (a objectKindAccessor) GetKind() string {
+	return a.kind.GroupVersionKind().Kind
+}
+
+func (a objectKindAccessor) SetKind(kind string) {
+	gvk := a.kind.GroupVersionKind()
+	gvk.Kind = kind
+	a.kind.SetGroupVersionKind(gvk)
+}
+
+// fieldKey is the key of fieldCache
+type fieldKey struct {
+	// the struct type of object
+	t reflect.Type
+	// the interface implemented by the pointer of field, or the type of field
+	target reflect.Type
+}
+
+// fieldCache caches the path of fields, a nil path marks the type without such field
+var fieldCache sync.Map
+
+// fieldByInterface returns the pointer to the field of obj implementing iface
+func fieldByInterface(obj any, iface reflect.Type) (reflect.Value, bool) {
+	return fieldBy(obj, iface, func(t reflect.Type) bool {
+		return reflect.PtrTo(t).Implements(iface)
+	})
+}
+
+// fieldByType returns the pointer to the field of obj in the type t
+func fieldByType(obj any, t reflect.Type) (reflect.Value, bool) {
+	return fieldBy(obj, t, func(ft reflect.Type) bool {
+		return ft == t
+	})
+}
+
+// fieldBy returns the pointer to the field of obj matched. The object is not changed, false is
+// returned when a nil pointer is on the path, e.g. the metadata held by a nil pointer.
+func fieldBy(obj any, target reflect.Type, match func(reflect.Type) bool) (reflect.Value, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	v = v.Elem()
+
+	key := fieldKey{t: v.Type(), target: target}
+	var path []int
+	if cached, ok := fieldCache.Load(key); ok {
+		path = cached.([]int)
+	} else {
+		path = findField(v.Type(), match)
+		fieldCache.Store(key, path)
+	}
+	if path == nil {
+		return reflect.Value{}, false
+	}
+
+	for _, i := range path {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if v.Kind() == reflect.Ptr {
+		return v, !v.IsNil()
+	}
+	return v.Addr(), true
+}
+
+// findField searches the exported fields of struct t breadth-first, and returns the path of the
+// shallowest field matched, the embedded structs are searched deeper.
+func findField(t reflect.Type, match func(reflect.Type) bool) []int {
+	type candidate struct {
+		t    reflect.Type
+		path []int
+	}
+
+	queue := []candidate{{t: t}}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+
+		for i := 0; i < c.t.NumField(); i++ {
+			f := c.t.Field(i)
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+
+			path := append(append([]int{}, c.path...), i)
+			if f.PkgPath != "" {
+				// the exported fields of the unexported embedded struct are still accessible
+				if f.Anonymous && f.Type.Kind() == reflect.Struct {
+					queue = append(queue, candidate{t: ft, path: path})
+				}
+				continue
+			}
+			if match(ft) {
+				return path
+			}
+			if f.Anonymous {
+				queue = append(queue, candidate{t: ft, path: path})
+			}
+		}
+	}
+
+	return nil
+}
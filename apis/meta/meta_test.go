@@ -0,0 +1,180 @@
+package meta
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+type namedMetaObj struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.EntityMeta `json:"metadata"`
+}
+
+type pointerMetaObj struct {
+	Metadata *metav1.ObjectMeta `json:"metadata"`
+}
+
+type nestedMetaObj struct {
+	namedMetaObj
+}
+
+type nonMetaObj struct {
+	Spec string `json:"spec"`
+}
+
+type namedListObj struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ListMeta `json:"metadata"`
+}
+
+// kindObj holds the GroupVersionKind without metav1.TypeMeta
+type kindObj struct {
+	gvk schema.GroupVersionKind
+}
+
+func (o *kindObj) GetObjectKind() schema.ObjectKind { return o }
+
+func (o *kindObj) SetGroupVersionKind(gvk schema.GroupVersionKind) { o.gvk = gvk }
+
+func (o *kindObj) GroupVersionKind() schema.GroupVersionKind { return o.gvk }
+
+func (o *kindObj) DeepCopyObject() runtime.Object {
+	out := *o
+	return &out
+}
+
+func (o *kindObj) DeepFromObject(in runtime.Object) {
+	*o = *in.DeepCopyObject().(*kindObj)
+}
+
+func TestAccessor(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  any
+		get  func(obj any) string
+	}{
+		{
+			name: "implements Meta",
+			obj:  &TestObj{},
+			get:  func(obj any) string { return obj.(*TestObj).Name },
+		},
+		{
+			name: "named field",
+			obj:  &namedMetaObj{},
+			get:  func(obj any) string { return obj.(*namedMetaObj).Metadata.Name },
+		},
+		{
+			name: "pointer field",
+			obj:  &pointerMetaObj{Metadata: &metav1.ObjectMeta{}},
+			get:  func(obj any) string { return obj.(*pointerMetaObj).Metadata.Name },
+		},
+		{
+			name: "embedded struct",
+			obj:  &nestedMetaObj{},
+			get:  func(obj any) string { return obj.(*nestedMetaObj).Metadata.Name },
+		},
+		{
+			name: "unstructured",
+			obj:  &runtime.Unstructured{},
+			get:  func(obj any) string { return obj.(*runtime.Unstructured).GetName() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Accessor(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.SetName("foo")
+			if got := tt.get(tt.obj); got != "foo" {
+				t.Fatalf("name = %q, want foo", got)
+			}
+
+			// the second lookup is served by the cache
+			m, err = Accessor(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.GetName() != "foo" {
+				t.Fatalf("GetName() = %q, want foo", m.GetName())
+			}
+		})
+	}
+
+	for _, obj := range []any{&nonMetaObj{}, nonMetaObj{}, (*namedMetaObj)(nil), "foo"} {
+		if _, err := Accessor(obj); !errors.Is(err, ErrNoMeta) {
+			t.Fatalf("Accessor(%T) = %v, want ErrNoMeta", obj, err)
+		}
+	}
+
+	// the nil pointer is left as is
+	nilMeta := &pointerMetaObj{}
+	if _, err := Accessor(nilMeta); !errors.Is(err, ErrNoMeta) {
+		t.Fatalf("Accessor(nil metadata) = %v, want ErrNoMeta", err)
+	}
+	if nilMeta.Metadata != nil {
+		t.Fatal("nil metadata is allocated by Accessor")
+	}
+
+	key := fieldKey{t: reflect.TypeOf(namedMetaObj{}), target: metaType}
+	if path, ok := fieldCache.Load(key); !ok || !reflect.DeepEqual(path, []int{1}) {
+		t.Fatalf("cached path = %v, want [1]", path)
+	}
+}
+
+func TestListAccessor(t *testing.T) {
+	list := &namedListObj{}
+	m, err := ListAccessor(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetTotal(3)
+	if list.Metadata.Total != 3 {
+		t.Fatalf("Total = %d, want 3", list.Metadata.Total)
+	}
+
+	if _, err = ListAccessor(&TestObjList{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ListAccessor(&nonMetaObj{}); !errors.Is(err, ErrNoListMeta) {
+		t.Fatalf("expected ErrNoListMeta, got %v", err)
+	}
+}
+
+func TestTypeAccessor(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  runtime.Object
+	}{
+		{name: "type meta", obj: &TestObj{}},
+		{name: "unstructured", obj: &runtime.Unstructured{}},
+		{name: "object kind", obj: &kindObj{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := TypeAccessor(tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a.SetAPIVersion(gv.String())
+			a.SetKind("Test")
+
+			want := gv.WithKind("Test")
+			if got := tt.obj.GetObjectKind().GroupVersionKind(); got != want {
+				t.Fatalf("GroupVersionKind() = %v, want %v", got, want)
+			}
+			if a.GetAPIVersion() != gv.String() || a.GetKind() != "Test" {
+				t.Fatalf("TypeAccessor() = %s %s", a.GetAPIVersion(), a.GetKind())
+			}
+		})
+	}
+
+	if _, err := TypeAccessor(&nonMetaObj{}); !errors.Is(err, ErrNoTypeMeta) {
+		t.Fatalf("expected ErrNoTypeMeta, got %v", err)
+	}
+}
@@ -0,0 +1,504 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+// RESTScopeName is the scope of resource
+type RESTScopeName string
+
+const (
+	// RESTScopeNameNamespace means the resource is scoped by namespace
+	RESTScopeNameNamespace RESTScopeName = "namespace"
+	// RESTScopeNameRoot means the resource is cluster scoped
+	RESTScopeNameRoot RESTScopeName = "root"
+)
+
+// RESTMapping contains the information needed to deal with objects of a specific
+// resource and kind in a RESTful manner.
+type RESTMapping struct {
+	// Resource is the GroupVersionResource (location) for this endpoint
+	Resource schema.GroupVersionResource
+
+	// GroupVersionKind is the GroupVersionKind (data format) to submit to this endpoint
+	GroupVersionKind schema.GroupVersionKind
+
+	// Scope contains the information needed to deal with REST Resources that are in a resource hierarchy
+	Scope RESTScopeName
+}
+
+// RESTMapper allows clients to map resources to kind, and map kind and version
+// to interfaces for manipulating those objects.
+type RESTMapper interface {
+	// KindFor takes a partial resource and returns the single match.  Returns an error if there are multiple matches
+	KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error)
+
+	// KindsFor takes a partial resource and returns the list of potential kinds in priority order
+	KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error)
+
+	// ResourceFor takes a partial resource and returns the single match.  Returns an error if there are multiple matches
+	ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error)
+
+	// ResourcesFor takes a partial resource and returns the list of potential resource in priority order
+	ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error)
+
+	// RESTMapping identifies a preferred resource mapping for the provided group kind.
+	RESTMapping(gk schema.GroupKind, versions ...string) (*RESTMapping, error)
+
+	// RESTMappings returns all resource mappings for the provided group kind if no
+	// version search is provided. Otherwise identifies a preferred resource mapping for
+	// the provided version(s).
+	RESTMappings(gk schema.GroupKind, versions ...string) ([]*RESTMapping, error)
+
+	// ResourceSingularizer converts a resource name from plural to singular (e.g., from pods to pod)
+	ResourceSingularizer(resource string) (singular string, err error)
+}
+
+// Scoper is implemented by the Object which is cluster scoped. The Object is namespace scoped
+// when it does not implement Scoper.
+type Scoper interface {
+	NamespaceScoped() bool
+}
+
+// ShortNamer is implemented by the Object which has short names, e.g. "deploy" of "deployments"
+type ShortNamer interface {
+	ShortNames() []string
+}
+
+// NoResourceMatchError is returned if the RESTMapper can't find any match for a resource
+type NoResourceMatchError struct {
+	PartialResource schema.GroupVersionResource
+}
+
+func (e *NoResourceMatchError) Error() string {
+	return fmt.Sprintf("no matches for %v", e.PartialResource)
+}
+
+// NoKindMatchError is returned if the RESTMapper can't find any match for a kind
+type NoKindMatchError struct {
+	// GroupKind is the API group and kind that was searched
+	GroupKind schema.GroupKind
+	// SearchedVersions is the optional list of versions the search was restricted to
+	SearchedVersions []string
+}
+
+func (e *NoKindMatchError) Error() string {
+	searchedVersions := strings.Join(e.SearchedVersions, ", ")
+	switch len(e.SearchedVersions) {
+	case 0:
+		return fmt.Sprintf("no matches for kind %q in group %q", e.GroupKind.Kind, e.GroupKind.Group)
+	case 1:
+		return fmt.Sprintf("no matches for kind %q in version %q", e.GroupKind.Kind, e.GroupKind.Group+"/"+searchedVersions)
+	default:
+		return fmt.Sprintf("no matches for kind %q in versions %q", e.GroupKind.Kind, searchedVersions)
+	}
+}
+
+// AmbiguousResourceError is returned if the RESTMapper finds multiple matches for a resource
+type AmbiguousResourceError struct {
+	PartialResource   schema.GroupVersionResource
+	MatchingResources []schema.GroupVersionResource
+}
+
+func (e *AmbiguousResourceError) Error() string {
+	return fmt.Sprintf("%v matches multiple resources %v", e.PartialResource, e.MatchingResources)
+}
+
+// IsNoMatchError checks whether the error is NoResourceMatchError or NoKindMatchError
+func IsNoMatchError(err error) bool {
+	switch err.(type) {
+	case *NoResourceMatchError, *NoKindMatchError:
+		return true
+	default:
+		return false
+	}
+}
+
+var _ RESTMapper = (*DefaultRESTMapper)(nil)
+
+// DefaultRESTMapper exposes mappings between the types defined in a runtime.Scheme.
+//
+// The resource name of a Kind is defined as the lowercase,
+// English-plural version of the Kind string.
+type DefaultRESTMapper struct {
+	mu sync.RWMutex
+
+	defaultGroupVersions []schema.GroupVersion
+
+	resourceToKind       map[schema.GroupVersionResource]schema.GroupVersionKind
+	kindToPluralResource map[schema.GroupVersionKind]schema.GroupVersionResource
+	kindToScope          map[schema.GroupVersionKind]RESTScopeName
+	singularToPlural     map[schema.GroupVersionResource]schema.GroupVersionResource
+	pluralToSingular     map[schema.GroupVersionResource]schema.GroupVersionResource
+	shortNames           map[string][]schema.GroupResource
+}
+
+// NewDefaultRESTMapper initializes a mapping between Kind and APIVersion
+// to a resource name and back based on the objects in a runtime.Scheme.
+// The defaultGroupVersions are in order of preference.
+func NewDefaultRESTMapper(defaultGroupVersions []schema.GroupVersion) *DefaultRESTMapper {
+	return &DefaultRESTMapper{
+		defaultGroupVersions: defaultGroupVersions,
+		resourceToKind:       map[schema.GroupVersionResource]schema.GroupVersionKind{},
+		kindToPluralResource: map[schema.GroupVersionKind]schema.GroupVersionResource{},
+		kindToScope:          map[schema.GroupVersionKind]RESTScopeName{},
+		singularToPlural:     map[schema.GroupVersionResource]schema.GroupVersionResource{},
+		pluralToSingular:     map[schema.GroupVersionResource]schema.GroupVersionResource{},
+		shortNames:           map[string][]schema.GroupResource{},
+	}
+}
+
+// NewRESTMapperFromScheme creates DefaultRESTMapper contains all Kinds registered in runtime.Scheme.
+// The lists are skipped, the scope and short names are declared by Scoper and ShortNamer.
+func NewRESTMapperFromScheme(scheme runtime.Scheme) *DefaultRESTMapper {
+	m := NewDefaultRESTMapper(scheme.PrioritizedVersionsAllGroups())
+
+	for _, obj := range scheme.AllObjects() {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if strings.HasSuffix(gvk.Kind, "List") && IsListType(obj) {
+			continue
+		}
+
+		scope := RESTScopeNameNamespace
+		if scoper, ok := obj.(Scoper); ok && !scoper.NamespaceScoped() {
+			scope = RESTScopeNameRoot
+		}
+		m.Add(gvk, scope)
+
+		if sn, ok := obj.(ShortNamer); ok {
+			plural, _ := UnsafeGuessKindToResource(gvk)
+			m.AddShortNames(plural.GroupResource(), sn.ShortNames()...)
+		}
+	}
+
+	return m
+}
+
+// Add adds the mapping of schema.GroupVersionKind, the resource name is guessed from Kind
+func (m *DefaultRESTMapper) Add(kind schema.GroupVersionKind, scope RESTScopeName) {
+	plural, singular := UnsafeGuessKindToResource(kind)
+	m.AddSpecific(kind, plural, singular, scope)
+}
+
+// AddSpecific adds the mapping of schema.GroupVersionKind with the specified resource names
+func (m *DefaultRESTMapper) AddSpecific(kind schema.GroupVersionKind, plural, singular schema.GroupVersionResource, scope RESTScopeName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.singularToPlural[singular] = plural
+	m.pluralToSingular[plural] = singular
+
+	m.resourceToKind[singular] = kind
+	m.resourceToKind[plural] = kind
+
+	m.kindToPluralResource[kind] = plural
+	m.kindToScope[kind] = scope
+}
+
+// AddShortNames adds the short names of schema.GroupResource
+func (m *DefaultRESTMapper) AddShortNames(resource schema.GroupResource, shortNames ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, name := range shortNames {
+		name = strings.ToLower(name)
+		exists := false
+		for _, gr := range m.shortNames[name] {
+			if gr == resource {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			m.shortNames[name] = append(m.shortNames[name], resource)
+		}
+	}
+}
+
+// unpluralizedSuffixes is a list of resource suffixes that are the same plural and singular
+var unpluralizedSuffixes = []string{
+	"endpoints",
+}
+
+// UnsafeGuessKindToResource converts Kind to a resource name.
+// Broken. This method only "sort of" works when used outside of this package.  It assumes that Kinds and Resources match
+// and they aren't guaranteed to do so.
+func UnsafeGuessKindToResource(kind schema.GroupVersionKind) ( /*plural*/ schema.GroupVersionResource /*singular*/, schema.GroupVersionResource) {
+	kindName := kind.Kind
+	if len(kindName) == 0 {
+		return schema.GroupVersionResource{}, schema.GroupVersionResource{}
+	}
+	singularName := strings.ToLower(kindName)
+	singular := kind.GroupVersion().WithResource(singularName)
+
+	for _, skip := range unpluralizedSuffixes {
+		if strings.HasSuffix(singularName, skip) {
+			return singular, singular
+		}
+	}
+
+	switch string(singularName[len(singularName)-1]) {
+	case "s":
+		return kind.GroupVersion().WithResource(singularName + "es"), singular
+	case "y":
+		if len(singularName) > 1 && !strings.ContainsAny(singularName[len(singularName)-2:len(singularName)-1], "aeiou") {
+			return kind.GroupVersion().WithResource(strings.TrimSuffix(singularName, "y") + "ies"), singular
+		}
+	}
+
+	return kind.GroupVersion().WithResource(singularName + "s"), singular
+}
+
+// ResourceSingularizer implements RESTMapper
+// It converts a resource name from plural to singular (e.g., from pods to pod)
+func (m *DefaultRESTMapper) ResourceSingularizer(resourceType string) (string, error) {
+	partialResource := schema.GroupVersionResource{Resource: resourceType}
+	resources, err := m.ResourcesFor(partialResource)
+	if err != nil {
+		return resourceType, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	singular := schema.GroupVersionResource{}
+	for _, curr := range resources {
+		currSingular, ok := m.pluralToSingular[curr]
+		if !ok {
+			continue
+		}
+		if singular.Empty() {
+			singular = currSingular
+			continue
+		}
+
+		if currSingular.Resource != singular.Resource {
+			return resourceType, fmt.Errorf("multiple possible singular resources (%v) found for %v", resources, resourceType)
+		}
+	}
+
+	if singular.Empty() {
+		return resourceType, fmt.Errorf("no singular of resource %v has been defined", resourceType)
+	}
+
+	return singular.Resource, nil
+}
+
+// coerceResourceForMatching makes the resource lower case
+func coerceResourceForMatching(resource schema.GroupVersionResource) schema.GroupVersionResource {
+	resource.Resource = strings.ToLower(resource.Resource)
+	return resource
+}
+
+// expandShortName replaces the short name by the full resource names
+func (m *DefaultRESTMapper) expandShortName(resource schema.GroupVersionResource) []schema.GroupVersionResource {
+	out := []schema.GroupVersionResource{resource}
+	for _, gr := range m.shortNames[resource.Resource] {
+		if len(resource.Group) != 0 && resource.Group != gr.Group {
+			continue
+		}
+		out = append(out, gr.WithVersion(resource.Version))
+	}
+	return out
+}
+
+// ResourcesFor returns all plural resources match the partial resource, in order of preferred versions
+func (m *DefaultRESTMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	resources := make([]schema.GroupVersionResource, 0)
+	seen := map[schema.GroupVersionResource]struct{}{}
+	for _, resource := range m.expandShortName(coerceResourceForMatching(input)) {
+		for plural, singular := range m.pluralToSingular {
+			if !resourceMatches(resource, plural) && !resourceMatches(resource, singular) {
+				continue
+			}
+			if _, ok := seen[plural]; ok {
+				continue
+			}
+			seen[plural] = struct{}{}
+			resources = append(resources, plural)
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil, &NoResourceMatchError{PartialResource: input}
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return m.versionPriority(resources[i].GroupVersion()) < m.versionPriority(resources[j].GroupVersion())
+	})
+	return resources, nil
+}
+
+// ResourceFor returns the plural resource matches the partial resource, the preferred version is
+// chosen when the resource is served in several versions
+func (m *DefaultRESTMapper) ResourceFor(resource schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	resources, err := m.ResourcesFor(resource)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+
+	// the resources are sorted by preference, the same GroupResource in other versions is not ambiguous
+	for _, r := range resources[1:] {
+		if r.GroupResource() != resources[0].GroupResource() {
+			return schema.GroupVersionResource{}, &AmbiguousResourceError{PartialResource: resource, MatchingResources: resources}
+		}
+	}
+	return resources[0], nil
+}
+
+// KindsFor returns all kinds match the partial resource, in order of preferred versions
+func (m *DefaultRESTMapper) KindsFor(input schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	resources, err := m.ResourcesFor(input)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	kinds := make([]schema.GroupVersionKind, 0, len(resources))
+	for _, resource := range resources {
+		kinds = append(kinds, m.resourceToKind[resource])
+	}
+	return kinds, nil
+}
+
+// KindFor returns the kind matches the partial resource
+func (m *DefaultRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	plural, err := m.ResourceFor(resource)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.resourceToKind[plural], nil
+}
+
+// RESTMapping returns a struct representing the resource path and conversion interfaces a
+// RESTClient should use to operate on the provided group/kind in order of versions. If a version search
+// order is not provided, the search order provided to DefaultRESTMapper will be used to resolve which
+// version should be used to access the named group/kind.
+func (m *DefaultRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*RESTMapping, error) {
+	mappings, err := m.RESTMappings(gk, versions...)
+	if err != nil {
+		return nil, err
+	}
+	return mappings[0], nil
+}
+
+// RESTMappings returns the RESTMappings for the provided group kind. If a version search order
+// is not provided, all mappings are returned in the preferred order.
+func (m *DefaultRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*RESTMapping, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	mappings := make([]*RESTMapping, 0)
+	potentialGVK := make([]schema.GroupVersionKind, 0)
+
+	// Pick an appropriate version
+	for _, version := range versions {
+		if len(version) == 0 {
+			continue
+		}
+		currGVK := gk.WithVersion(version)
+		if _, ok := m.kindToPluralResource[currGVK]; ok {
+			potentialGVK = append(potentialGVK, currGVK)
+			break
+		}
+	}
+	// Use the default preferred versions
+	if len(versions) == 0 {
+		for gvk := range m.kindToPluralResource {
+			if gvk.GroupKind() == gk {
+				potentialGVK = append(potentialGVK, gvk)
+			}
+		}
+		sort.SliceStable(potentialGVK, func(i, j int) bool {
+			pi, pj := m.versionPriority(potentialGVK[i].GroupVersion()), m.versionPriority(potentialGVK[j].GroupVersion())
+			if pi != pj {
+				return pi < pj
+			}
+			return potentialGVK[i].Version < potentialGVK[j].Version
+		})
+	}
+
+	for _, gvk := range potentialGVK {
+		// Ensure we have a REST mapping
+		res, ok := m.kindToPluralResource[gvk]
+		if !ok {
+			continue
+		}
+
+		// Ensure we have a REST scope
+		scope, ok := m.kindToScope[gvk]
+		if !ok {
+			return nil, fmt.Errorf("the provided version %q and kind %q cannot be mapped to a supported scope", gvk.GroupVersion(), gvk.Kind)
+		}
+
+		mappings = append(mappings, &RESTMapping{
+			Resource:         res,
+			GroupVersionKind: gvk,
+			Scope:            scope,
+		})
+	}
+
+	if len(mappings) == 0 {
+		return nil, &NoKindMatchError{GroupKind: gk, SearchedVersions: versions}
+	}
+	return mappings, nil
+}
+
+// versionPriority returns the index of schema.GroupVersion in defaultGroupVersions,
+// the unknown versions are placed at last
+func (m *DefaultRESTMapper) versionPriority(gv schema.GroupVersion) int {
+	for i, item := range m.defaultGroupVersions {
+		if item == gv {
+			return i
+		}
+	}
+	return len(m.defaultGroupVersions)
+}
+
+// resourceMatches returns true if the provided pattern matches the resource, the empty
+// fields of pattern match any value.
+func resourceMatches(pattern, resource schema.GroupVersionResource) bool {
+	if len(pattern.Group) != 0 && pattern.Group != resource.Group {
+		return false
+	}
+	if len(pattern.Version) != 0 && pattern.Version != resource.Version {
+		return false
+	}
+	return pattern.Resource == resource.Resource
+}
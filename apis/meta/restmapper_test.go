@@ -0,0 +1,167 @@
+package meta
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vine-io/apimachinery/schema"
+)
+
+func TestUnsafeGuessKindToResource(t *testing.T) {
+	tests := []struct {
+		kind     string
+		plural   string
+		singular string
+	}{
+		{kind: "Pod", plural: "pods", singular: "pod"},
+		{kind: "Class", plural: "classes", singular: "class"},
+		{kind: "Policy", plural: "policies", singular: "policy"},
+		{kind: "Gateway", plural: "gateways", singular: "gateway"},
+		{kind: "Endpoints", plural: "endpoints", singular: "endpoints"},
+		{kind: "", plural: "", singular: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			plural, singular := UnsafeGuessKindToResource(gv.WithKind(tt.kind))
+			if plural.Resource != tt.plural {
+				t.Errorf("plural = %s, want %s", plural.Resource, tt.plural)
+			}
+			if singular.Resource != tt.singular {
+				t.Errorf("singular = %s, want %s", singular.Resource, tt.singular)
+			}
+		})
+	}
+}
+
+func newTestMapper() *DefaultRESTMapper {
+	v1 := schema.GroupVersion{Group: "test", Version: "v1"}
+	v2 := schema.GroupVersion{Group: "test", Version: "v2"}
+	other := schema.GroupVersion{Group: "other", Version: "v1"}
+
+	m := NewDefaultRESTMapper([]schema.GroupVersion{v2, v1, other})
+	m.Add(v1.WithKind("Policy"), RESTScopeNameNamespace)
+	m.Add(v2.WithKind("Policy"), RESTScopeNameNamespace)
+	m.Add(v1.WithKind("Node"), RESTScopeNameRoot)
+	m.Add(other.WithKind("Policy"), RESTScopeNameRoot)
+	m.AddShortNames(v1.WithResource("policies").GroupResource(), "po")
+	return m
+}
+
+func TestDefaultRESTMapper_ResourceFor(t *testing.T) {
+	m := newTestMapper()
+
+	tests := []struct {
+		name    string
+		input   schema.GroupVersionResource
+		want    schema.GroupVersionResource
+		wantErr error
+	}{
+		{
+			name:  "plural",
+			input: schema.GroupVersionResource{Group: "test", Resource: "policies"},
+			want:  schema.GroupVersionResource{Group: "test", Version: "v2", Resource: "policies"},
+		},
+		{
+			name:  "singular with version",
+			input: schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "Policy"},
+			want:  schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "policies"},
+		},
+		{
+			name:  "short name",
+			input: schema.GroupVersionResource{Resource: "po"},
+			want:  schema.GroupVersionResource{Group: "test", Version: "v2", Resource: "policies"},
+		},
+		{
+			name:    "ambiguous",
+			input:   schema.GroupVersionResource{Resource: "policies"},
+			wantErr: &AmbiguousResourceError{},
+		},
+		{
+			name:    "no match",
+			input:   schema.GroupVersionResource{Resource: "foos"},
+			wantErr: &NoResourceMatchError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.ResourceFor(tt.input)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("want error %T, got nil", tt.wantErr)
+				}
+				switch tt.wantErr.(type) {
+				case *AmbiguousResourceError:
+					var target *AmbiguousResourceError
+					if !errors.As(err, &target) {
+						t.Fatalf("want AmbiguousResourceError, got %v", err)
+					}
+				case *NoResourceMatchError:
+					if !IsNoMatchError(err) {
+						t.Fatalf("want NoResourceMatchError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ResourceFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRESTMapper_KindFor(t *testing.T) {
+	m := newTestMapper()
+
+	gvk, err := m.KindFor(schema.GroupVersionResource{Resource: "nodes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schema.GroupVersionKind{Group: "test", Version: "v1", Kind: "Node"}); gvk != want {
+		t.Errorf("KindFor() = %v, want %v", gvk, want)
+	}
+}
+
+func TestDefaultRESTMapper_RESTMapping(t *testing.T) {
+	m := newTestMapper()
+
+	mapping, err := m.RESTMapping(schema.GroupKind{Group: "test", Kind: "Policy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapping.GroupVersionKind.Version != "v2" {
+		t.Errorf("preferred version = %s, want v2", mapping.GroupVersionKind.Version)
+	}
+	if mapping.Scope != RESTScopeNameNamespace {
+		t.Errorf("scope = %s, want %s", mapping.Scope, RESTScopeNameNamespace)
+	}
+
+	mapping, err = m.RESTMapping(schema.GroupKind{Group: "test", Kind: "Node"}, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapping.Scope != RESTScopeNameRoot || mapping.Resource.Resource != "nodes" {
+		t.Errorf("mapping = %+v", mapping)
+	}
+
+	_, err = m.RESTMapping(schema.GroupKind{Group: "test", Kind: "Node"}, "v3")
+	if !IsNoMatchError(err) {
+		t.Errorf("want NoKindMatchError, got %v", err)
+	}
+}
+
+func TestDefaultRESTMapper_ResourceSingularizer(t *testing.T) {
+	m := newTestMapper()
+
+	singular, err := m.ResourceSingularizer("policies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if singular != "policy" {
+		t.Errorf("ResourceSingularizer() = %s, want policy", singular)
+	}
+}
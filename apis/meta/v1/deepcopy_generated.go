@@ -18,6 +18,30 @@
 
 package v1
 
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an auto-generated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepFrom is an auto-generated deepcopy function, copying from Condition.
+func (in *Condition) DeepFrom(o *Condition) {
+	if in == nil {
+		return
+	}
+	o.DeepCopyInto(in)
+}
+
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
 func (in *EntityMeta) DeepCopyInto(out *EntityMeta) {
 	*out = *in
@@ -40,12 +64,17 @@ func (in *EntityMeta) DeepCopyInto(out *EntityMeta) {
 		*out = make([]*OwnerReference, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
-				in, out := &(*out)[i], &(*out)[i]
+				in, out := &(*in)[i], &(*out)[i]
 				*out = new(OwnerReference)
 				**out = **in
 			}
 		}
 	}
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -91,6 +120,30 @@ func (in *ListMeta) DeepFrom(o *ListMeta) {
 	o.DeepCopyInto(in)
 }
 
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *ListOptions) DeepCopyInto(out *ListOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an auto-generated deepcopy function, copying the receiver, creating a new ListOptions.
+func (in *ListOptions) DeepCopy() *ListOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ListOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepFrom is an auto-generated deepcopy function, copying from ListOptions.
+func (in *ListOptions) DeepFrom(o *ListOptions) {
+	if in == nil {
+		return
+	}
+	o.DeepCopyInto(in)
+}
+
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
 func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
 	*out = *in
@@ -113,12 +166,17 @@ func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
 		*out = make([]*OwnerReference, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
-				in, out := &(*out)[i], &(*out)[i]
+				in, out := &(*in)[i], &(*out)[i]
 				*out = new(OwnerReference)
 				**out = **in
 			}
 		}
 	}
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -188,6 +246,95 @@ func (in *State) DeepFrom(o *State) {
 	o.DeepCopyInto(in)
 }
 
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *Status) DeepCopyInto(out *Status) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = new(StatusDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an auto-generated deepcopy function, copying the receiver, creating a new Status.
+func (in *Status) DeepCopy() *Status {
+	if in == nil {
+		return nil
+	}
+	out := new(Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepFrom is an auto-generated deepcopy function, copying from Status.
+func (in *Status) DeepFrom(o *Status) {
+	if in == nil {
+		return
+	}
+	o.DeepCopyInto(in)
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *StatusCause) DeepCopyInto(out *StatusCause) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an auto-generated deepcopy function, copying the receiver, creating a new StatusCause.
+func (in *StatusCause) DeepCopy() *StatusCause {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCause)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepFrom is an auto-generated deepcopy function, copying from StatusCause.
+func (in *StatusCause) DeepFrom(o *StatusCause) {
+	if in == nil {
+		return
+	}
+	o.DeepCopyInto(in)
+}
+
+// DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
+func (in *StatusDetails) DeepCopyInto(out *StatusDetails) {
+	*out = *in
+	if in.Causes != nil {
+		in, out := &in.Causes, &out.Causes
+		*out = make([]*StatusCause, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StatusCause)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an auto-generated deepcopy function, copying the receiver, creating a new StatusDetails.
+func (in *StatusDetails) DeepCopy() *StatusDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepFrom is an auto-generated deepcopy function, copying from StatusDetails.
+func (in *StatusDetails) DeepFrom(o *StatusDetails) {
+	if in == nil {
+		return
+	}
+	o.DeepCopyInto(in)
+}
+
 // DeepCopyInto is an auto-generated deepcopy function, coping the receiver, writing into out. in must be no-nil.
 func (in *TypeMeta) DeepCopyInto(out *TypeMeta) {
 	*out = *in
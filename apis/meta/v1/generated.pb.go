@@ -40,11 +40,40 @@ var _ = ebinary.BigEndian
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1628c045e819208d, []int{0}
+}
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return m.XSize()
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
 func (m *EntityMeta) Reset()         { *m = EntityMeta{} }
 func (m *EntityMeta) String() string { return proto.CompactTextString(m) }
 func (*EntityMeta) ProtoMessage()    {}
 func (*EntityMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{0}
+	return fileDescriptor_1628c045e819208d, []int{1}
 }
 func (m *EntityMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,7 +102,7 @@ func (m *ListMeta) Reset()         { *m = ListMeta{} }
 func (m *ListMeta) String() string { return proto.CompactTextString(m) }
 func (*ListMeta) ProtoMessage()    {}
 func (*ListMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{1}
+	return fileDescriptor_1628c045e819208d, []int{2}
 }
 func (m *ListMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,11 +127,40 @@ func (m *ListMeta) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListMeta proto.InternalMessageInfo
 
+func (m *ListOptions) Reset()         { *m = ListOptions{} }
+func (m *ListOptions) String() string { return proto.CompactTextString(m) }
+func (*ListOptions) ProtoMessage()    {}
+func (*ListOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1628c045e819208d, []int{3}
+}
+func (m *ListOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ListOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOptions.Merge(m, src)
+}
+func (m *ListOptions) XXX_Size() int {
+	return m.XSize()
+}
+func (m *ListOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOptions proto.InternalMessageInfo
+
 func (m *ObjectMeta) Reset()         { *m = ObjectMeta{} }
 func (m *ObjectMeta) String() string { return proto.CompactTextString(m) }
 func (*ObjectMeta) ProtoMessage()    {}
 func (*ObjectMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{2}
+	return fileDescriptor_1628c045e819208d, []int{4}
 }
 func (m *ObjectMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +189,7 @@ func (m *OwnerReference) Reset()         { *m = OwnerReference{} }
 func (m *OwnerReference) String() string { return proto.CompactTextString(m) }
 func (*OwnerReference) ProtoMessage()    {}
 func (*OwnerReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{3}
+	return fileDescriptor_1628c045e819208d, []int{5}
 }
 func (m *OwnerReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,7 +218,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{4}
+	return fileDescriptor_1628c045e819208d, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,11 +243,98 @@ func (m *State) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_State proto.InternalMessageInfo
 
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1628c045e819208d, []int{7}
+}
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return m.XSize()
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *StatusCause) Reset()         { *m = StatusCause{} }
+func (m *StatusCause) String() string { return proto.CompactTextString(m) }
+func (*StatusCause) ProtoMessage()    {}
+func (*StatusCause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1628c045e819208d, []int{8}
+}
+func (m *StatusCause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusCause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatusCause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusCause.Merge(m, src)
+}
+func (m *StatusCause) XXX_Size() int {
+	return m.XSize()
+}
+func (m *StatusCause) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusCause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusCause proto.InternalMessageInfo
+
+func (m *StatusDetails) Reset()         { *m = StatusDetails{} }
+func (m *StatusDetails) String() string { return proto.CompactTextString(m) }
+func (*StatusDetails) ProtoMessage()    {}
+func (*StatusDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1628c045e819208d, []int{9}
+}
+func (m *StatusDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatusDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusDetails.Merge(m, src)
+}
+func (m *StatusDetails) XXX_Size() int {
+	return m.XSize()
+}
+func (m *StatusDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusDetails proto.InternalMessageInfo
+
 func (m *TypeMeta) Reset()         { *m = TypeMeta{} }
 func (m *TypeMeta) String() string { return proto.CompactTextString(m) }
 func (*TypeMeta) ProtoMessage()    {}
 func (*TypeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1628c045e819208d, []int{5}
+	return fileDescriptor_1628c045e819208d, []int{10}
 }
 func (m *TypeMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,15 +360,20 @@ func (m *TypeMeta) XXX_DiscardUnknown() {
 var xxx_messageInfo_TypeMeta proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*Condition)(nil), "v1.Condition")
 	proto.RegisterType((*EntityMeta)(nil), "v1.EntityMeta")
 	proto.RegisterMapType((github_com_vine_io_apimachinery_storage_dao.Map[string, string])(nil), "v1.EntityMeta.AnnotationsEntry")
 	proto.RegisterMapType((github_com_vine_io_apimachinery_storage_dao.Map[string, string])(nil), "v1.EntityMeta.LabelsEntry")
 	proto.RegisterType((*ListMeta)(nil), "v1.ListMeta")
+	proto.RegisterType((*ListOptions)(nil), "v1.ListOptions")
 	proto.RegisterType((*ObjectMeta)(nil), "v1.ObjectMeta")
 	proto.RegisterMapType((github_com_vine_io_apimachinery_storage_dao.Map[string, string])(nil), "v1.ObjectMeta.AnnotationsEntry")
 	proto.RegisterMapType((github_com_vine_io_apimachinery_storage_dao.Map[string, string])(nil), "v1.ObjectMeta.LabelsEntry")
 	proto.RegisterType((*OwnerReference)(nil), "v1.OwnerReference")
 	proto.RegisterType((*State)(nil), "v1.State")
+	proto.RegisterType((*Status)(nil), "v1.Status")
+	proto.RegisterType((*StatusCause)(nil), "v1.StatusCause")
+	proto.RegisterType((*StatusDetails)(nil), "v1.StatusDetails")
 	proto.RegisterType((*TypeMeta)(nil), "v1.TypeMeta")
 }
 
@@ -232,58 +382,114 @@ func init() {
 }
 
 var fileDescriptor_1628c045e819208d = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0xac, 0x93, 0xcd, 0xe6, 0x25, 0x74, 0xbb, 0x43, 0x25, 0x4c, 0x24, 0x9c, 0x28, 0x5c,
-	0x16, 0xa9, 0x8d, 0xb5, 0x45, 0x48, 0x05, 0x21, 0xd0, 0xba, 0xad, 0x7a, 0xa0, 0xdb, 0xc2, 0x74,
-	0xdb, 0x43, 0x11, 0x12, 0x13, 0xfb, 0xd5, 0x1d, 0x1a, 0xff, 0x90, 0x67, 0x12, 0x94, 0x9e, 0xb8,
-	0x70, 0xe7, 0xca, 0x5f, 0xc0, 0x7f, 0xc0, 0xdf, 0xb0, 0xc7, 0x3d, 0xee, 0x29, 0x62, 0xb3, 0x37,
-	0xfe, 0x84, 0x9e, 0x90, 0xc7, 0x76, 0x62, 0xa7, 0x45, 0x25, 0x88, 0x03, 0xaa, 0x7a, 0xb2, 0x67,
-	0xbe, 0xef, 0x7b, 0xfe, 0xde, 0x68, 0xde, 0x27, 0xc3, 0xe7, 0xbe, 0x50, 0x4f, 0x27, 0xa3, 0xa1,
-	0x1b, 0x05, 0xf6, 0x54, 0x84, 0x78, 0x4d, 0x44, 0x36, 0x8f, 0x45, 0xc0, 0xdd, 0xa7, 0x22, 0xc4,
-	0x64, 0x96, 0x2e, 0xa4, 0x1d, 0xa0, 0xe2, 0xf6, 0xf4, 0xc0, 0xf6, 0x31, 0xc4, 0x84, 0x2b, 0xf4,
-	0x86, 0x71, 0x12, 0xa9, 0x88, 0x6e, 0x4d, 0x0f, 0xba, 0xd7, 0x4a, 0x15, 0xfc, 0xc8, 0x8f, 0x6c,
-	0x0d, 0x8d, 0x26, 0x4f, 0xf4, 0x4a, 0x2f, 0xf4, 0x5b, 0x26, 0x19, 0xfc, 0xd9, 0x04, 0xb8, 0x1d,
-	0x2a, 0xa1, 0x66, 0x47, 0xa8, 0x38, 0xed, 0x43, 0x3d, 0xe4, 0x01, 0x9a, 0xa4, 0x4f, 0xf6, 0x5b,
-	0x4e, 0xe7, 0x64, 0xde, 0xab, 0x2d, 0xe6, 0xbd, 0xfa, 0x3d, 0x1e, 0x20, 0xd3, 0x08, 0xfd, 0x00,
-	0x8c, 0x89, 0xf0, 0xcc, 0xad, 0x3e, 0xd9, 0x37, 0x9c, 0x76, 0x4e, 0x30, 0x1e, 0x0a, 0x8f, 0xa5,
-	0xfb, 0xf4, 0x10, 0x76, 0x13, 0x94, 0xd1, 0x24, 0x71, 0xf1, 0x11, 0x26, 0x52, 0x44, 0xa1, 0x69,
-	0xe8, 0x5a, 0xef, 0xe5, 0xd4, 0x5d, 0x56, 0x85, 0xd9, 0x3a, 0x9f, 0x7e, 0x02, 0x6d, 0x0f, 0xa5,
-	0x9b, 0x88, 0x58, 0xa5, 0xf2, 0xba, 0x96, 0xbf, 0x9b, 0xcb, 0xdb, 0xb7, 0x56, 0x10, 0x2b, 0xf3,
-	0xa8, 0x0d, 0xad, 0xd4, 0xa0, 0x8c, 0xb9, 0x8b, 0x66, 0x43, 0x8b, 0xf6, 0x72, 0x51, 0xeb, 0x5e,
-	0x01, 0xb0, 0x15, 0x87, 0xde, 0x81, 0x3d, 0x37, 0x41, 0x9e, 0x8a, 0x8f, 0x45, 0x80, 0x52, 0xf1,
-	0x20, 0x36, 0xb7, 0x75, 0x5f, 0xef, 0xe7, 0xc2, 0xbd, 0x9b, 0xeb, 0x04, 0xf6, 0xb2, 0x26, 0xed,
-	0x79, 0x12, 0x7b, 0x5c, 0xe1, 0xaa, 0x4c, 0x53, 0x97, 0x59, 0xf6, 0xfc, 0xb0, 0x0a, 0xb3, 0x75,
-	0x7e, 0xea, 0xc5, 0xc3, 0x31, 0x56, 0xbd, 0xec, 0x54, 0xbd, 0xdc, 0x5a, 0x27, 0xb0, 0x97, 0x35,
-	0xf4, 0x06, 0x74, 0x8a, 0x5b, 0x91, 0x36, 0x6d, 0xb6, 0xf4, 0x41, 0x5c, 0xc9, 0x6b, 0x74, 0xee,
-	0x94, 0x30, 0x56, 0x61, 0xd2, 0x9f, 0x09, 0x6c, 0x8f, 0xf9, 0x08, 0xc7, 0xd2, 0x84, 0xbe, 0xb1,
-	0xdf, 0xbe, 0xde, 0x1d, 0x4e, 0x0f, 0x86, 0xab, 0xbb, 0x31, 0xbc, 0xab, 0xc1, 0xdb, 0xa1, 0x4a,
-	0x66, 0xce, 0x37, 0x79, 0xc1, 0xed, 0x6c, 0xf3, 0xc5, 0xbc, 0xf7, 0xe5, 0xeb, 0x6e, 0xae, 0x54,
-	0x51, 0xc2, 0x7d, 0xb4, 0x3d, 0x1e, 0x0d, 0x8f, 0x78, 0xfc, 0xad, 0x54, 0x89, 0x08, 0xfd, 0xab,
-	0xfd, 0xec, 0xf9, 0x1d, 0xcb, 0x3f, 0x4e, 0x7f, 0x25, 0xd0, 0xe6, 0x61, 0x18, 0x29, 0x7d, 0xca,
-	0xd2, 0x6c, 0x6b, 0x33, 0xbd, 0x35, 0x33, 0x87, 0x2b, 0x46, 0xe6, 0xe8, 0x51, 0x71, 0x41, 0x4a,
-	0xc8, 0x7f, 0x61, 0xab, 0xec, 0x85, 0x3a, 0x00, 0x09, 0x3e, 0xc1, 0x04, 0x43, 0x17, 0xa5, 0xd9,
-	0xd1, 0xce, 0x68, 0xea, 0xec, 0xfe, 0x8f, 0x21, 0x26, 0xac, 0x80, 0x9c, 0x4b, 0x8b, 0x79, 0x0f,
-	0x96, 0x4b, 0xc9, 0x4a, 0xaa, 0xee, 0xa7, 0xd0, 0x2e, 0x9d, 0x24, 0xbd, 0x0c, 0xc6, 0x33, 0x9c,
-	0x65, 0x03, 0xc7, 0xd2, 0x57, 0x7a, 0x05, 0x1a, 0x53, 0x3e, 0x9e, 0xa0, 0x9e, 0xb1, 0x16, 0xcb,
-	0x16, 0x9f, 0x6d, 0xdd, 0x20, 0xdd, 0x2f, 0xe0, 0xf2, 0x7a, 0xdf, 0x9b, 0xe8, 0x07, 0xbf, 0x13,
-	0xd8, 0xb9, 0x2b, 0xa4, 0xd2, 0xa3, 0xfe, 0x8a, 0x49, 0x25, 0x1b, 0x4e, 0x6a, 0x1f, 0xea, 0x31,
-	0xf7, 0xb3, 0x0f, 0x35, 0x56, 0x69, 0xf1, 0x35, 0xf7, 0x91, 0x69, 0x24, 0x65, 0x48, 0xf1, 0x1c,
-	0x75, 0x06, 0x94, 0x18, 0x0f, 0xc4, 0x73, 0x64, 0x1a, 0xa1, 0x1f, 0x42, 0x43, 0x45, 0x8a, 0x8f,
-	0xf5, 0x9c, 0x1b, 0xce, 0x3b, 0x39, 0xa5, 0x71, 0x9c, 0x6e, 0xb2, 0x0c, 0xd3, 0x29, 0x75, 0x7f,
-	0xf4, 0x03, 0xba, 0x6a, 0xf3, 0x94, 0x6a, 0xbd, 0x4d, 0xa9, 0x37, 0x3e, 0xa5, 0x56, 0x77, 0xe3,
-	0x7f, 0x90, 0x52, 0x25, 0x33, 0x6f, 0x53, 0xea, 0x5f, 0xa4, 0xd4, 0x6f, 0x04, 0x2e, 0x55, 0x9d,
-	0xd2, 0xeb, 0x00, 0x3c, 0x16, 0xd5, 0x98, 0xa2, 0xf9, 0x51, 0xc2, 0xe1, 0x12, 0x61, 0x25, 0x56,
-	0x1a, 0x12, 0xcf, 0x44, 0x58, 0x64, 0xc0, 0x32, 0x24, 0xbe, 0x12, 0xa1, 0xc7, 0x34, 0xb2, 0x8c,
-	0x11, 0xe3, 0x75, 0x31, 0x52, 0x7f, 0x75, 0x8c, 0x0c, 0x46, 0xd0, 0x78, 0xa0, 0xb8, 0x42, 0x3a,
-	0x84, 0xba, 0x1b, 0x79, 0x59, 0x20, 0x35, 0x9c, 0x6e, 0x51, 0xe9, 0x66, 0xe4, 0xe1, 0x8b, 0x79,
-	0x0f, 0x52, 0xd2, 0x44, 0xa6, 0x2b, 0xa6, 0x79, 0xf4, 0x23, 0x68, 0x06, 0x28, 0x65, 0x91, 0x9d,
-	0x2d, 0x67, 0x37, 0x97, 0x34, 0x8f, 0xb2, 0x6d, 0x56, 0xe0, 0x83, 0xef, 0x61, 0xe7, 0x78, 0x16,
-	0x63, 0x91, 0x7b, 0xba, 0x25, 0xf2, 0xb7, 0x2d, 0x55, 0x0f, 0x6a, 0xeb, 0x9f, 0x1c, 0x94, 0xf3,
-	0xf8, 0xe4, 0xdc, 0xaa, 0x9d, 0x9e, 0x5b, 0xb5, 0xb3, 0x73, 0x8b, 0xfc, 0xb4, 0xb0, 0xc8, 0xc9,
-	0xc2, 0x22, 0xa7, 0x0b, 0x8b, 0x9c, 0x2d, 0x2c, 0xf2, 0xc7, 0xc2, 0x22, 0xbf, 0x5c, 0x58, 0xb5,
-	0xd3, 0x0b, 0xab, 0x76, 0x76, 0x61, 0xd5, 0x1e, 0x5f, 0xdd, 0xe4, 0x0f, 0x75, 0xb4, 0xad, 0xff,
-	0x32, 0x3f, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x79, 0xeb, 0x11, 0x6d, 0xd8, 0x0a, 0x00, 0x00,
+	// 1205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4b, 0x8f, 0x1b, 0x45,
+	0x17, 0x9d, 0x1e, 0x3f, 0xc6, 0xbe, 0x9e, 0x47, 0xa6, 0xbe, 0x28, 0x5f, 0x63, 0x89, 0xb6, 0x31,
+	0x08, 0x8c, 0x92, 0xd8, 0xca, 0x20, 0xa4, 0x80, 0x10, 0x28, 0x9d, 0x44, 0x59, 0x90, 0x07, 0xd4,
+	0x4c, 0xb2, 0x08, 0x42, 0x4a, 0xb9, 0xbb, 0xc6, 0x29, 0x62, 0x77, 0xb5, 0xba, 0xca, 0x46, 0xce,
+	0x8a, 0x0d, 0x7b, 0xb6, 0xfc, 0x02, 0x24, 0x7e, 0x49, 0x96, 0x23, 0x56, 0x61, 0x63, 0x11, 0xe7,
+	0x37, 0xb0, 0x99, 0x15, 0xaa, 0x87, 0xfb, 0xe1, 0x19, 0x14, 0x46, 0x42, 0x02, 0xa1, 0xac, 0xc6,
+	0xf7, 0x9e, 0x53, 0xd5, 0xe7, 0xde, 0xbe, 0x7d, 0xa6, 0x0a, 0x3e, 0x19, 0x32, 0xf9, 0x78, 0x32,
+	0xe8, 0x05, 0x7c, 0xdc, 0x9f, 0xb2, 0x88, 0x5e, 0x66, 0xbc, 0x4f, 0x62, 0x36, 0x26, 0xc1, 0x63,
+	0x16, 0xd1, 0x64, 0xa6, 0x02, 0xd1, 0x1f, 0x53, 0x49, 0xfa, 0xd3, 0x2b, 0xfd, 0x21, 0x8d, 0x68,
+	0x42, 0x24, 0x0d, 0x7b, 0x71, 0xc2, 0x25, 0x47, 0xeb, 0xd3, 0x2b, 0xcd, 0xcb, 0xb9, 0x1d, 0x86,
+	0x7c, 0xc8, 0xfb, 0x1a, 0x1a, 0x4c, 0x0e, 0x75, 0xa4, 0x03, 0xfd, 0xcb, 0x2c, 0xe9, 0xcc, 0x1d,
+	0xa8, 0x5f, 0xe7, 0x51, 0xc8, 0x24, 0xe3, 0x11, 0x42, 0x50, 0x96, 0xb3, 0x98, 0xba, 0x4e, 0xdb,
+	0xe9, 0xd6, 0xb1, 0xfe, 0x8d, 0x2e, 0x42, 0x55, 0x48, 0x22, 0x27, 0xc2, 0x5d, 0x57, 0x59, 0xff,
+	0x7f, 0xc7, 0xf3, 0xd6, 0x4e, 0xba, 0x64, 0x5f, 0x43, 0xd8, 0x52, 0x50, 0x0f, 0x10, 0x1f, 0x08,
+	0x9a, 0x4c, 0x69, 0x78, 0xcb, 0x88, 0x63, 0x3c, 0x72, 0x4b, 0x6d, 0xa7, 0x5b, 0xc2, 0xa7, 0x20,
+	0x8a, 0x3f, 0x22, 0x42, 0x1e, 0x24, 0x24, 0x12, 0x7a, 0xbf, 0x03, 0x36, 0xa6, 0x6e, 0xd9, 0xf0,
+	0x4f, 0x22, 0xe8, 0x02, 0x54, 0x13, 0x4a, 0x04, 0x8f, 0xdc, 0x8a, 0x96, 0x68, 0x23, 0xe4, 0xc2,
+	0xc6, 0x98, 0x0a, 0x41, 0x86, 0xd4, 0xad, 0x6a, 0x60, 0x19, 0x76, 0x7e, 0xae, 0x01, 0xdc, 0x8c,
+	0x24, 0x93, 0xb3, 0x3b, 0x54, 0x12, 0xd4, 0x86, 0x72, 0x44, 0xc6, 0xb6, 0x42, 0x7f, 0xf3, 0xd9,
+	0xbc, 0xb5, 0xb6, 0x98, 0xb7, 0xca, 0x77, 0xc9, 0x98, 0x62, 0x8d, 0xa0, 0x37, 0xa1, 0x34, 0x61,
+	0xa1, 0x2e, 0xb6, 0xe4, 0x37, 0x2c, 0xa1, 0x74, 0x9f, 0x85, 0x58, 0xe5, 0xd1, 0x35, 0xd8, 0x49,
+	0xa8, 0xe0, 0x93, 0x24, 0xa0, 0x0f, 0x68, 0x22, 0x96, 0xe5, 0xd5, 0xfd, 0xff, 0x5b, 0xea, 0x0e,
+	0x2e, 0xc2, 0x78, 0x95, 0x8f, 0x3e, 0x84, 0x46, 0x48, 0x45, 0x90, 0xb0, 0x58, 0x77, 0xa7, 0x6c,
+	0xda, 0x6a, 0x97, 0x37, 0x6e, 0x64, 0x10, 0xce, 0xf3, 0x50, 0x1f, 0xea, 0x4a, 0xa0, 0x88, 0x49,
+	0x40, 0x4d, 0xf9, 0xfe, 0xae, 0x5d, 0x54, 0xbf, 0xbb, 0x04, 0x70, 0xc6, 0x41, 0xb7, 0x60, 0x37,
+	0x48, 0x28, 0x59, 0x36, 0x4f, 0x48, 0x32, 0x8e, 0x75, 0x7b, 0x4a, 0xfe, 0x1b, 0x76, 0xe1, 0xee,
+	0xf5, 0x55, 0x02, 0x3e, 0xb9, 0x46, 0xd5, 0x3c, 0x89, 0x43, 0x22, 0x69, 0xb6, 0xcd, 0x86, 0xde,
+	0x26, 0xad, 0xf9, 0x7e, 0x11, 0xc6, 0xab, 0x7c, 0xa5, 0x25, 0xa4, 0x23, 0x5a, 0xd4, 0x52, 0x2b,
+	0x6a, 0xb9, 0xb1, 0x4a, 0xc0, 0x27, 0xd7, 0xa0, 0xab, 0xb0, 0xb9, 0x1c, 0x7b, 0x55, 0xb4, 0x5b,
+	0xd7, 0x8d, 0x38, 0x6f, 0xf7, 0xd8, 0xbc, 0x95, 0xc3, 0x70, 0x81, 0x89, 0xbe, 0x77, 0xa0, 0x3a,
+	0x22, 0x03, 0x3a, 0x12, 0x2e, 0xb4, 0x4b, 0xdd, 0xc6, 0x5e, 0xb3, 0x37, 0xbd, 0xd2, 0xcb, 0x66,
+	0xa3, 0x77, 0x5b, 0x83, 0x37, 0x23, 0x99, 0xcc, 0xfc, 0x2f, 0xed, 0x86, 0x55, 0x93, 0x3c, 0x9e,
+	0xb7, 0x3e, 0x7b, 0xd5, 0xa7, 0x29, 0x24, 0x4f, 0xc8, 0x90, 0xf6, 0x43, 0xc2, 0x7b, 0x77, 0x48,
+	0xfc, 0x95, 0x90, 0x09, 0x8b, 0x86, 0x97, 0xda, 0xe6, 0xef, 0xd7, 0xd8, 0x3e, 0x1c, 0xfd, 0xe8,
+	0x40, 0x83, 0x44, 0x11, 0x97, 0xba, 0xcb, 0xc2, 0x6d, 0x68, 0x31, 0xad, 0x15, 0x31, 0xd7, 0x32,
+	0x86, 0x51, 0xf4, 0x60, 0x39, 0x20, 0x39, 0xe4, 0xef, 0x90, 0x95, 0xd7, 0x82, 0x7c, 0x80, 0x84,
+	0x1e, 0xd2, 0x84, 0x46, 0x01, 0x15, 0xee, 0xa6, 0x56, 0x86, 0x94, 0xb2, 0x7b, 0xdf, 0x46, 0x34,
+	0xc1, 0x4b, 0xc8, 0xdf, 0x5e, 0xcc, 0x5b, 0x90, 0x86, 0x02, 0xe7, 0x56, 0x21, 0x0f, 0xe0, 0x90,
+	0x45, 0x64, 0xc4, 0x9e, 0xd2, 0x44, 0xb8, 0x5b, 0xed, 0x52, 0xb7, 0x8e, 0x73, 0x19, 0x85, 0x0f,
+	0x33, 0x6f, 0xd8, 0xd6, 0xdf, 0x7a, 0x2e, 0xd3, 0xfc, 0x08, 0x1a, 0xb9, 0x37, 0x81, 0xce, 0x41,
+	0xe9, 0x09, 0x9d, 0x59, 0x4b, 0x52, 0x3f, 0xd1, 0x79, 0xa8, 0x4c, 0xc9, 0x68, 0x42, 0x8d, 0x21,
+	0x61, 0x13, 0x7c, 0xbc, 0x7e, 0xd5, 0x69, 0x7e, 0x0a, 0xe7, 0x56, 0xfb, 0x76, 0x96, 0xf5, 0x9d,
+	0x5f, 0x1c, 0xa8, 0xdd, 0x66, 0x42, 0x6a, 0xab, 0x38, 0xe5, 0x4b, 0x77, 0xce, 0xf8, 0xa5, 0xb7,
+	0xa1, 0x1c, 0x93, 0xa1, 0x79, 0x50, 0x25, 0x73, 0x9b, 0x2f, 0xc8, 0x90, 0x62, 0x8d, 0x28, 0x86,
+	0x60, 0x4f, 0xa9, 0x5b, 0x2a, 0x32, 0xf6, 0xd9, 0x53, 0x8a, 0x35, 0x82, 0xde, 0x86, 0x8a, 0xe4,
+	0x92, 0x8c, 0x8c, 0x2b, 0xfa, 0x5b, 0x96, 0x52, 0x39, 0x50, 0x49, 0x6c, 0x30, 0xd4, 0x84, 0x5a,
+	0xc0, 0x23, 0xc9, 0xa2, 0x89, 0xb5, 0x06, 0x9c, 0xc6, 0x9d, 0xdf, 0x1d, 0x68, 0xa8, 0xa2, 0xee,
+	0xc5, 0xe6, 0x1d, 0xbf, 0x03, 0x5b, 0x7a, 0x12, 0xf7, 0xe9, 0x88, 0x06, 0x92, 0x27, 0xb6, 0x35,
+	0xc5, 0xa4, 0x62, 0x1d, 0x32, 0x3a, 0x0a, 0x53, 0x96, 0x69, 0x56, 0x31, 0xa9, 0x5a, 0x39, 0x62,
+	0x63, 0x26, 0xad, 0xc5, 0x9b, 0xa0, 0xa0, 0xa6, 0x5c, 0x54, 0x83, 0xba, 0x27, 0xbb, 0x6a, 0x04,
+	0x9f, 0x68, 0xde, 0x05, 0xa8, 0x0a, 0x9e, 0x48, 0x7f, 0x66, 0x2d, 0xdd, 0x46, 0xe8, 0x5d, 0xd8,
+	0x96, 0x6c, 0x4c, 0xf9, 0x44, 0xee, 0xd3, 0x80, 0x47, 0xa1, 0x30, 0x66, 0x84, 0x57, 0xb2, 0xda,
+	0xf9, 0xef, 0x0d, 0xbe, 0xa1, 0x81, 0x3c, 0xbb, 0xf3, 0xd7, 0x5f, 0x3b, 0xff, 0x7f, 0xde, 0xf9,
+	0xb3, 0xd9, 0xf8, 0x17, 0x38, 0x7f, 0x4e, 0xcc, 0x6b, 0xe7, 0xff, 0x07, 0x9c, 0xff, 0x27, 0x07,
+	0xb6, 0x8b, 0x95, 0xa2, 0x3d, 0x00, 0x12, 0xb3, 0xa2, 0xf5, 0x23, 0xfb, 0x2a, 0xe0, 0x5a, 0x8a,
+	0xe0, 0x1c, 0x4b, 0x99, 0xcc, 0x13, 0x16, 0x2d, 0x3d, 0x24, 0x35, 0x99, 0xcf, 0x59, 0x14, 0x62,
+	0x8d, 0xa4, 0x36, 0x54, 0x7a, 0x95, 0x0d, 0x95, 0x4f, 0xb7, 0xa1, 0xce, 0x00, 0x2a, 0xea, 0xd0,
+	0x4d, 0x51, 0x0f, 0xca, 0x01, 0x0f, 0x8d, 0xa1, 0x55, 0xfc, 0xe6, 0x72, 0xa7, 0xeb, 0x3c, 0xa4,
+	0xc7, 0xf3, 0x16, 0x98, 0x93, 0xb9, 0x8a, 0xb0, 0xe6, 0xa1, 0xf7, 0xb3, 0x33, 0xb2, 0x91, 0xb7,
+	0x63, 0x97, 0x6c, 0xdc, 0x31, 0xe9, 0xec, 0xd0, 0xfc, 0xab, 0x03, 0x55, 0xb3, 0x1e, 0xed, 0x41,
+	0x4d, 0x5d, 0x03, 0xd4, 0x64, 0xea, 0x27, 0x35, 0xf6, 0x36, 0xd5, 0x54, 0x1c, 0xd8, 0x9c, 0x5f,
+	0x53, 0x9b, 0x1c, 0xcd, 0x5b, 0x0e, 0x4e, 0x79, 0xda, 0xb9, 0x73, 0x57, 0x86, 0xf4, 0x76, 0x80,
+	0xac, 0x62, 0xfd, 0xcf, 0xce, 0xaa, 0xea, 0xa6, 0x27, 0x7a, 0x53, 0xf0, 0xb9, 0xe3, 0x79, 0x6b,
+	0xd3, 0xde, 0x2a, 0x74, 0xfe, 0xb4, 0x33, 0x7e, 0xa5, 0x70, 0xc6, 0x47, 0x17, 0x61, 0x23, 0xa4,
+	0x92, 0xb0, 0x91, 0xd0, 0x26, 0xd7, 0xd8, 0xdb, 0x55, 0x12, 0xcd, 0x26, 0x37, 0x0c, 0x80, 0x97,
+	0x8c, 0xce, 0x23, 0x68, 0xd8, 0xd6, 0x90, 0x89, 0xa0, 0xe8, 0xad, 0xfc, 0x95, 0xc7, 0xdf, 0x3a,
+	0x9e, 0xb7, 0xea, 0x1a, 0x50, 0x05, 0xda, 0x1b, 0x90, 0xbb, 0xd2, 0xb8, 0xec, 0xc1, 0xe7, 0xa1,
+	0xa2, 0xff, 0x1f, 0x9a, 0xb7, 0x89, 0x4d, 0xd0, 0x99, 0xc2, 0x56, 0xe1, 0xd9, 0x8a, 0x36, 0x4c,
+	0xf8, 0x24, 0xb6, 0xa3, 0x68, 0x02, 0xd5, 0x8d, 0x6c, 0x56, 0xec, 0x74, 0xa0, 0xfc, 0x74, 0xd8,
+	0x79, 0x78, 0x0f, 0xaa, 0x81, 0x52, 0x24, 0xdc, 0xb2, 0xfe, 0x2a, 0x77, 0xb2, 0xe2, 0xb4, 0x52,
+	0x6c, 0xe1, 0xce, 0x23, 0xa8, 0x2d, 0x5f, 0x4b, 0x3a, 0x88, 0xce, 0x9f, 0x0e, 0x62, 0x71, 0xbc,
+	0xd7, 0xff, 0xca, 0x78, 0xfb, 0x0f, 0x9f, 0xbd, 0xf0, 0xd6, 0x8e, 0x5e, 0x78, 0x6b, 0xcf, 0x5f,
+	0x78, 0xce, 0x77, 0x0b, 0xcf, 0x79, 0xb6, 0xf0, 0x9c, 0xa3, 0x85, 0xe7, 0x3c, 0x5f, 0x78, 0xce,
+	0x6f, 0x0b, 0xcf, 0xf9, 0xe1, 0xa5, 0xb7, 0x76, 0xf4, 0xd2, 0x5b, 0x7b, 0xfe, 0xd2, 0x5b, 0x7b,
+	0x78, 0xe9, 0x2c, 0x97, 0xd9, 0x41, 0x55, 0x5f, 0x48, 0x3f, 0xf8, 0x63, 0x00, 0xf1, 0x81, 0xd6,
+	0x33, 0x03, 0x0f, 0x00, 0x00,
+}
+
+func (m *Condition) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ObservedGeneration != 0 {
+		n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	}
+	if m.LastTransitionTime != 0 {
+		n += 1 + sovGenerated(uint64(m.LastTransitionTime))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
 
 func (m *EntityMeta) XSize() (n int) {
@@ -346,6 +552,15 @@ func (m *EntityMeta) XSize() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Generation != 0 {
+		n += 1 + sovGenerated(uint64(m.Generation))
+	}
 	return n
 }
 
@@ -368,6 +583,45 @@ func (m *ListMeta) XSize() (n int) {
 	if m.Total != 0 {
 		n += 1 + sovGenerated(uint64(m.Total))
 	}
+	l = len(m.Continue)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOptions) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FieldSelector)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovGenerated(uint64(m.Limit))
+	}
+	l = len(m.Continue)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SortBy)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	}
 	return n
 }
 
@@ -432,6 +686,15 @@ func (m *ObjectMeta) XSize() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Generation != 0 {
+		n += 1 + sovGenerated(uint64(m.Generation))
+	}
 	return n
 }
 
@@ -476,6 +739,84 @@ func (m *State) XSize() (n int) {
 	return n
 }
 
+func (m *Status) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TypeMeta.XSize()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovGenerated(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.XSize()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusCause) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusDetails) XSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Causes) > 0 {
+		for _, e := range m.Causes {
+			l = e.XSize()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TypeMeta) XSize() (n int) {
 	if m == nil {
 		return 0
@@ -499,7 +840,7 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *EntityMeta) Marshal() (dAtA []byte, err error) {
+func (m *Condition) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -509,49 +850,124 @@ func (m *EntityMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntityMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.XSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntityMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.References) > 0 {
-		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.References[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastTransitionTime != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastTransitionTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservedGeneration != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityMeta) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Generation != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.References[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x5a
@@ -659,6 +1075,13 @@ func (m *ListMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Continue) > 0 {
+		i -= len(m.Continue)
+		copy(dAtA[i:], m.Continue)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Continue)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Total != 0 {
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Total))
 		i--
@@ -684,6 +1107,74 @@ func (m *ListMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ListOptions) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SortBy) > 0 {
+		i -= len(m.SortBy)
+		copy(dAtA[i:], m.SortBy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.SortBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Continue) > 0 {
+		i -= len(m.Continue)
+		copy(dAtA[i:], m.Continue)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Continue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Limit != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FieldSelector) > 0 {
+		i -= len(m.FieldSelector)
+		copy(dAtA[i:], m.FieldSelector)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LabelSelector) > 0 {
+		i -= len(m.LabelSelector)
+		copy(dAtA[i:], m.LabelSelector)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
@@ -704,6 +1195,20 @@ func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Generation != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.References) > 0 {
 		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -912,6 +1417,179 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.TypeMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusCause) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusCause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusCause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusDetails) Marshal() (dAtA []byte, err error) {
+	size := m.XSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.XSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Causes) > 0 {
+		for iNdEx := len(m.Causes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Causes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TypeMeta) Marshal() (dAtA []byte, err error) {
 	size := m.XSize()
 	dAtA = make([]byte, size)
@@ -960,7 +1638,7 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *EntityMeta) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -983,10 +1661,226 @@ func (m *EntityMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntityMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntityMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			m.LastTransitionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTransitionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1513,6 +2407,57 @@ func (m *EntityMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -1652,13 +2597,45 @@ func (m *ListMeta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -1673,7 +2650,7 @@ func (m *ListMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+func (m *ListOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1696,15 +2673,15 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1732,11 +2709,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,11 +2741,30 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.FieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1796,11 +2792,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Continue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1828,11 +2824,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1860,13 +2856,13 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.SortBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			m.CreationTimestamp = 0
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1876,16 +2872,66 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationTimestamp |= int64(b&0x7F) << shift
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UpdateTimestamp = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1895,16 +2941,29 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTimestamp", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DeletionTimestamp = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1914,14 +2973,27 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeletionTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1949,13 +3021,13 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenerateName = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -1965,81 +3037,234 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(github_com_vine_io_apimachinery_storage_dao.Map[string, string])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			m.CreationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimestamp", wireType)
+			}
+			m.UpdateTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTimestamp", wireType)
+			}
+			m.DeletionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenerateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(github_com_vine_io_apimachinery_storage_dao.Map[string, string])
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
@@ -2239,6 +3464,57 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2539,6 +3815,566 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TypeMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = StatusReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &StatusDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusCause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusCause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusCause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = CauseType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Causes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Causes = append(m.Causes, &StatusCause{})
+			if err := m.Causes[len(m.Causes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TypeMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
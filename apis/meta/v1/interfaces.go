@@ -66,6 +66,10 @@ type Meta interface {
 	SetGenerateName(cn string)
 	GetReferences() []*OwnerReference
 	SetReferences(references []*OwnerReference)
+	GetFinalizers() []string
+	SetFinalizers(finalizers []string)
+	GetGeneration() int64
+	SetGeneration(generation int64)
 }
 
 var _ Meta = (*ObjectMeta)(nil)
@@ -166,6 +170,22 @@ func (m *ObjectMeta) SetReferences(references []*OwnerReference) {
 	m.References = references
 }
 
+func (m *ObjectMeta) GetFinalizers() []string {
+	return m.Finalizers
+}
+
+func (m *ObjectMeta) SetFinalizers(finalizers []string) {
+	m.Finalizers = finalizers
+}
+
+func (m *ObjectMeta) GetGeneration() int64 {
+	return m.Generation
+}
+
+func (m *ObjectMeta) SetGeneration(generation int64) {
+	m.Generation = generation
+}
+
 func (m *ObjectMeta) PrimaryKey() (string, any, bool) {
 	return "uid", m.Uid, m.Uid == ""
 }
@@ -268,6 +288,22 @@ func (m *EntityMeta) SetReferences(references []*OwnerReference) {
 	m.References = references
 }
 
+func (m *EntityMeta) GetFinalizers() []string {
+	return m.Finalizers
+}
+
+func (m *EntityMeta) SetFinalizers(finalizers []string) {
+	m.Finalizers = finalizers
+}
+
+func (m *EntityMeta) GetGeneration() int64 {
+	return m.Generation
+}
+
+func (m *EntityMeta) SetGeneration(generation int64) {
+	m.Generation = generation
+}
+
 func (m *EntityMeta) PrimaryKey() (string, any, bool) {
 	return "uid", m.Uid, m.Uid == 0
 }
@@ -292,6 +328,8 @@ type Lister interface {
 	SetSize(s int32)
 	GetTotal() int64
 	SetTotal(total int64)
+	GetContinue() string
+	SetContinue(c string)
 }
 
 func (m *ListMeta) GetResourceVersion() string {
@@ -325,3 +363,11 @@ func (m *ListMeta) GetTotal() int64 {
 func (m *ListMeta) SetTotal(total int64) {
 	m.Total = total
 }
+
+func (m *ListMeta) GetContinue() string {
+	return m.Continue
+}
+
+func (m *ListMeta) SetContinue(c string) {
+	m.Continue = c
+}
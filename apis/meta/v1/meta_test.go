@@ -1,9 +1,16 @@
 package v1
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/vine-io/apimachinery/validation/field"
 )
 
 func TestMarshal(t *testing.T) {
@@ -24,3 +31,155 @@ func TestMarshal(t *testing.T) {
 		t.Fatal("m1 != mm")
 	}
 }
+
+func TestMarshalStatus(t *testing.T) {
+	s := &Status{
+		TypeMeta: TypeMeta{Kind: "Status", ApiVersion: "v1"},
+		Status:   StatusFailure,
+		Code:     422,
+		Reason:   StatusReasonInvalid,
+		Message:  "invalid",
+		Details: &StatusDetails{
+			Kind:   "Pod",
+			Name:   "p1",
+			Causes: []*StatusCause{{Type: CauseTypeFieldValueRequired, Field: "metadata.name"}},
+		},
+	}
+
+	data, err := proto.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ss Status
+	if err = proto.Unmarshal(data, &ss); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(s, &ss) {
+		t.Fatalf("Unmarshal() = %v, want %v", &ss, s)
+	}
+	if c := ss.DeepCopy(); !reflect.DeepEqual(c, s) || c.Details == s.Details {
+		t.Fatalf("DeepCopy() = %v", c)
+	}
+}
+
+func TestMarshalListOptions(t *testing.T) {
+	opts := &ListOptions{
+		LabelSelector:   "app=foo",
+		FieldSelector:   "metadata.name=bar",
+		Limit:           10,
+		Continue:        "token",
+		ResourceVersion: "1",
+		SortBy:          "-metadata.name",
+		TimeoutSeconds:  30,
+	}
+
+	data, err := proto.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out ListOptions
+	if err = proto.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(opts, &out) {
+		t.Fatalf("Unmarshal() = %v, want %v", &out, opts)
+	}
+
+	if err = (&ListOptions{Limit: -1}).Validate(); err == nil {
+		t.Fatal("expected error for negative limit")
+	}
+}
+
+func TestValidateMeta(t *testing.T) {
+	tests := []struct {
+		name string
+		meta interface {
+			ValidateFields(fldPath *field.Path) field.ErrorList
+		}
+		fields []string
+	}{
+		{name: "valid", meta: &ObjectMeta{Name: "foo", Namespace: "default", Labels: map[string]string{"vine.io/app": "foo"}}},
+		{name: "generate name", meta: &EntityMeta{GenerateName: "foo-"}},
+		{name: "name required", meta: &ObjectMeta{}, fields: []string{"metadata.name"}},
+		{
+			name:   "invalid",
+			meta:   &EntityMeta{Name: "Foo", Namespace: "a.b", Labels: map[string]string{"a/b/c": "d e"}},
+			fields: []string{"metadata.name", "metadata.namespace", "metadata.labels", "metadata.labels[a/b/c]"},
+		},
+		{
+			name:   "annotations too long",
+			meta:   &ObjectMeta{Name: "foo", Annotations: map[string]string{"a": strings.Repeat("a", 256*1024)}},
+			fields: []string{"metadata.annotations"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.meta.ValidateFields(field.NewPath("metadata"))
+			got := map[string]bool{}
+			for _, e := range errs {
+				got[e.Field] = true
+			}
+			if len(got) != len(tt.fields) {
+				t.Fatalf("ValidateFields() = %v, want errors of %v", errs, tt.fields)
+			}
+			for _, f := range tt.fields {
+				if !got[f] {
+					t.Errorf("ValidateFields() = %v, want error of %s", errs, f)
+				}
+			}
+		})
+	}
+}
+
+// TestValidatorGenerated checks meta.pb.validator.go is left as proto-gen-validator generates it,
+// the validation of metadata is written in validation.go instead of calling from the generated code.
+func TestValidatorGenerated(t *testing.T) {
+	const filename = "meta.pb.validator.go"
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Comments) == 0 || !strings.HasPrefix(file.Comments[0].Text(), "Code generated by proto-gen-validator") {
+		t.Fatalf("%s is not generated by proto-gen-validator", filename)
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		// the generated code calls builtins and the functions of imported packages only
+		if ident, ok := call.Fun.(*ast.Ident); ok && types.Universe.Lookup(ident.Name) == nil {
+			t.Errorf("%s: %s is not generated by proto-gen-validator, regenerate the file", fset.Position(call.Pos()), ident.Name)
+		}
+		return true
+	})
+}
+
+// TestDeepCopyReferences guards deepcopy_generated.go, the OwnerReferences must be copied from
+// the source instead of the destination. Check it again after the file is regenerated.
+func TestDeepCopyReferences(t *testing.T) {
+	refs := []*OwnerReference{{ApiVersion: "v1", Kind: "Owner", Name: "o1", Uid: "1"}, nil}
+
+	om := &ObjectMeta{References: refs}
+	oc := om.DeepCopy()
+	if !reflect.DeepEqual(oc.References, om.References) {
+		t.Fatalf("ObjectMeta.References = %v, want %v", oc.References, om.References)
+	}
+	if oc.References[0] == refs[0] {
+		t.Fatal("ObjectMeta.References shares OwnerReference with the source")
+	}
+
+	em := &EntityMeta{References: refs}
+	ec := em.DeepCopy()
+	if !reflect.DeepEqual(ec.References, em.References) {
+		t.Fatalf("EntityMeta.References = %v, want %v", ec.References, em.References)
+	}
+	if ec.References[0] == refs[0] {
+		t.Fatal("EntityMeta.References shares OwnerReference with the source")
+	}
+}
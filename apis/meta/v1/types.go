@@ -102,6 +102,12 @@ type EntityMeta struct {
 	// 资源关系信息
 	// 该资源示例能稳定运行的依赖资源
 	References dao.JSONArray[*OwnerReference] `json:"references" protobuf:"bytes,12,rep,name=references,proto3"`
+	// 资源终结器
+	// 非空时删除资源只设置 DeletionTimestamp，所有终结器移除后资源才会被删除
+	Finalizers dao.Array[string] `json:"finalizers,omitempty" protobuf:"bytes,13,rep,name=finalizers,proto3"`
+	// 资源期望状态的版本
+	// 只读，仅在 metadata 和 status 以外的字段变化时递增
+	Generation int64 `json:"generation,omitempty" protobuf:"varint,14,opt,name=generation,proto3"`
 }
 
 // +gogo:deepcopy=true
@@ -135,6 +141,12 @@ type ObjectMeta struct {
 	// 资源关系信息
 	// 该资源示例能稳定运行的依赖资源
 	References dao.JSONArray[*OwnerReference] `json:"references" protobuf:"bytes,12,rep,name=references,proto3"`
+	// 资源终结器
+	// 非空时删除资源只设置 DeletionTimestamp，所有终结器移除后资源才会被删除
+	Finalizers dao.Array[string] `json:"finalizers,omitempty" protobuf:"bytes,13,rep,name=finalizers,proto3"`
+	// 资源期望状态的版本
+	// 只读，仅在 metadata 和 status 以外的字段变化时递增
+	Generation int64 `json:"generation,omitempty" protobuf:"varint,14,opt,name=generation,proto3"`
 }
 
 // Value return json value, implement driver.Valuer interface
@@ -159,13 +171,221 @@ type ListMeta struct {
 	Page            int32  `json:"page,omitempty" protobuf:"varint,2,opt,name=page,proto3"`
 	Size            int32  `json:"size,omitempty" protobuf:"varint,3,opt,name=size,proto3"`
 	Total           int64  `json:"total,omitempty" protobuf:"varint,4,opt,name=total,proto3"`
+	// Continue is the token to retrieve the next chunk of list, it's empty when there are no more objects
+	Continue string `json:"continue,omitempty" protobuf:"bytes,5,opt,name=continue,proto3"`
+}
+
+// +gogo:deepcopy=true
+// +gogo:genproto=true
+// ListOptions is the query options to a standard REST list call.
+type ListOptions struct {
+	// A selector to restrict the list of returned objects by their labels, e.g. "app=foo,tier!=db"
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,1,opt,name=labelSelector,proto3"`
+	// A selector to restrict the list of returned objects by their fields, e.g. "metadata.name=foo"
+	FieldSelector string `json:"fieldSelector,omitempty" protobuf:"bytes,2,opt,name=fieldSelector,proto3"`
+	// The maximum number of objects returned, all objects are returned when it's 0
+	// +gen:gte=0
+	Limit int64 `json:"limit,omitempty" protobuf:"varint,3,opt,name=limit,proto3"`
+	// The continue token returned by the previous list call in ListMeta, used to retrieve the next chunk
+	Continue string `json:"continue,omitempty" protobuf:"bytes,4,opt,name=continue,proto3"`
+	// The resourceVersion the list is served from, the latest objects are returned when it's empty
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,5,opt,name=resourceVersion,proto3"`
+	// The fields the list is sorted by, e.g. "metadata.name,-metadata.creationTimestamp",
+	// the field prefixed with '-' is sorted in descending order
+	SortBy string `json:"sortBy,omitempty" protobuf:"bytes,6,opt,name=sortBy,proto3"`
+	// Timeout for the list/watch call in seconds, no timeout when it's 0
+	// +gen:gte=0
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,7,opt,name=timeoutSeconds,proto3"`
 }
 
 // +gogo:deepcopy=true
 // +gogo:genproto=true
 // 资源状态
 type State struct {
+	// +gen:in=[0, 1, 2]
 	Code StatusCode `json:"code,omitempty" protobuf:"varint,1,opt,name=code,proto3,casttype=StatusCode"`
 	// code != 0 时，显示错误信息
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message,proto3"`
 }
+
+// ConditionStatus is the status of a Condition
+type ConditionStatus string
+
+// These are valid condition statuses. "ConditionTrue" means a resource is in the condition.
+// "ConditionFalse" means a resource is not in the condition. "ConditionUnknown" means the
+// state of condition can not be decided.
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// +gogo:deepcopy=true
+// +gogo:genproto=true
+// Condition contains details for one aspect of the current state of resource
+type Condition struct {
+	// type of condition in CamelCase, e.g. "Ready"
+	// +gen:required
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type,proto3"`
+	// status of the condition, one of True, False, Unknown
+	// +gen:in=["True","False","Unknown"]
+	Status ConditionStatus `json:"status" protobuf:"bytes,2,opt,name=status,proto3,casttype=ConditionStatus"`
+	// the .metadata.generation that the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,3,opt,name=observedGeneration,proto3"`
+	// the last time (unix seconds) the condition transitioned from one status to another
+	LastTransitionTime int64 `json:"lastTransitionTime" protobuf:"varint,4,opt,name=lastTransitionTime,proto3"`
+	// a programmatic identifier indicating the reason for the condition's last transition, in CamelCase
+	Reason string `json:"reason" protobuf:"bytes,5,opt,name=reason,proto3"`
+	// a human-readable message indicating details about the transition
+	Message string `json:"message" protobuf:"bytes,6,opt,name=message,proto3"`
+}
+
+// Value return json value, implement driver.Valuer interface
+func (m *Condition) Value() (driver.Value, error) {
+	return dao.GetValue(m)
+}
+
+// Scan scan value into Jsonb, implements sql.Scanner interface
+func (m *Condition) Scan(value any) error {
+	return dao.ScanValue(value, m)
+}
+
+// GormDBDataType implements migrator.GormDBDataTypeInterface interface
+func (m *Condition) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return dao.GetGormDBDataType(db, field)
+}
+
+// StatusReason is an enumeration of possible failure causes. Each StatusReason
+// must map to a single HTTP status code, but multiple reasons may map
+// to the same HTTP status code.
+type StatusReason string
+
+const (
+	// StatusReasonUnknown means the server has declined to indicate a specific reason.
+	StatusReasonUnknown StatusReason = ""
+	// StatusReasonUnauthorized means the server can be reached and understood the request, but requires
+	// the user to present appropriate authorization credentials.
+	StatusReasonUnauthorized StatusReason = "Unauthorized"
+	// StatusReasonForbidden means the server can be reached and understood the request, but refuses
+	// to take any further action.
+	StatusReasonForbidden StatusReason = "Forbidden"
+	// StatusReasonNotFound means one or more resources required for this operation
+	// could not be found.
+	StatusReasonNotFound StatusReason = "NotFound"
+	// StatusReasonAlreadyExists means the resource you are creating already exists.
+	StatusReasonAlreadyExists StatusReason = "AlreadyExists"
+	// StatusReasonConflict means the requested operation cannot be completed
+	// due to a conflict in the operation, e.g. the resourceVersion is out of date.
+	StatusReasonConflict StatusReason = "Conflict"
+	// StatusReasonGone means the item is no longer available at the server.
+	StatusReasonGone StatusReason = "Gone"
+	// StatusReasonInvalid means the requested create or update operation cannot be
+	// completed due to invalid data provided as part of the request, the details
+	// contain the list of invalid fields.
+	StatusReasonInvalid StatusReason = "Invalid"
+	// StatusReasonTimeout means that the request could not be completed within the given time.
+	StatusReasonTimeout StatusReason = "Timeout"
+	// StatusReasonTooManyRequests means the server experienced too many requests within a
+	// given window and that the client must wait to perform the action again.
+	StatusReasonTooManyRequests StatusReason = "TooManyRequests"
+	// StatusReasonBadRequest means that the request itself was invalid.
+	StatusReasonBadRequest StatusReason = "BadRequest"
+	// StatusReasonMethodNotAllowed means that the action the client attempted to perform
+	// on the resource was not supported by the code.
+	StatusReasonMethodNotAllowed StatusReason = "MethodNotAllowed"
+	// StatusReasonInternalError indicates that an internal error occurred, it is unexpected
+	// and the outcome of the call is unknown.
+	StatusReasonInternalError StatusReason = "InternalError"
+	// StatusReasonServiceUnavailable means that the request itself was valid,
+	// but the requested service is unavailable at this time.
+	StatusReasonServiceUnavailable StatusReason = "ServiceUnavailable"
+)
+
+// CauseType is a machine readable value providing more detail about what
+// occurred in a status response.
+type CauseType string
+
+const (
+	// CauseTypeFieldValueNotFound is used to report failure to find a requested value.
+	CauseTypeFieldValueNotFound CauseType = "FieldValueNotFound"
+	// CauseTypeFieldValueRequired is used to report required values that are not provided.
+	CauseTypeFieldValueRequired CauseType = "FieldValueRequired"
+	// CauseTypeFieldValueDuplicate is used to report collisions of values that must be unique.
+	CauseTypeFieldValueDuplicate CauseType = "FieldValueDuplicate"
+	// CauseTypeFieldValueInvalid is used to report malformed values.
+	CauseTypeFieldValueInvalid CauseType = "FieldValueInvalid"
+	// CauseTypeFieldValueNotSupported is used to report valid (as per formatting rules)
+	// values that can not be handled.
+	CauseTypeFieldValueNotSupported CauseType = "FieldValueNotSupported"
+	// CauseTypeUnexpectedServerResponse is used to report when the server responded to the client
+	// without the expected return type.
+	CauseTypeUnexpectedServerResponse CauseType = "UnexpectedServerResponse"
+)
+
+// Values of Status.Status
+const (
+	StatusSuccess = "Success"
+	StatusFailure = "Failure"
+)
+
+// +gogo:deepcopy=true
+// +gogo:genproto=true
+// Status is a return value for calls that don't return other objects.
+type Status struct {
+	TypeMeta `json:",inline" protobuf:"bytes,1,opt,name=typeMeta,proto3,embedded=typeMeta"`
+	// Status of the operation, one of: "Success" or "Failure".
+	Status string `json:"status,omitempty" protobuf:"bytes,2,opt,name=status,proto3"`
+	// Suggested HTTP return code for this status, 0 if not set.
+	Code int32 `json:"code,omitempty" protobuf:"varint,3,opt,name=code,proto3"`
+	// A machine-readable description of why this operation is in the "Failure" status.
+	Reason StatusReason `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason,proto3,casttype=StatusReason"`
+	// A human-readable description of the status of this operation.
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message,proto3"`
+	// Extended data associated with the reason.
+	Details *StatusDetails `json:"details,omitempty" protobuf:"bytes,6,opt,name=details,proto3"`
+}
+
+// +gogo:deepcopy=true
+// +gogo:genproto=true
+// StatusDetails is a set of additional properties that MAY be set by the
+// server to provide additional information about a response.
+type StatusDetails struct {
+	// The group attribute of the resource associated with the status StatusReason.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group,proto3"`
+	// The kind attribute of the resource associated with the status StatusReason.
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind,proto3"`
+	// The name or uid attribute of the resource associated with the status StatusReason.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name,proto3"`
+	// The Causes array includes more details associated with the StatusReason failure.
+	Causes []*StatusCause `json:"causes,omitempty" protobuf:"bytes,4,rep,name=causes,proto3"`
+}
+
+// +gogo:deepcopy=true
+// +gogo:genproto=true
+// StatusCause provides more information about a Status failure, including
+// cases when multiple errors are encountered.
+type StatusCause struct {
+	// A machine-readable description of the cause of the error.
+	Type CauseType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,proto3,casttype=CauseType"`
+	// A human-readable description of the cause of the error.
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message,proto3"`
+	// The field of the resource that has caused this error, e.g. "metadata.name".
+	Field string `json:"field,omitempty" protobuf:"bytes,3,opt,name=field,proto3"`
+}
+
+// DeletionPropagation decides if a deletion will propagate to the dependents of the object,
+// and how the garbage collector will handle the propagation.
+type DeletionPropagation string
+
+const (
+	// DeletePropagationOrphan orphans the dependents, the OwnerReference of the object is removed from them.
+	DeletePropagationOrphan DeletionPropagation = "Orphan"
+	// DeletePropagationBackground deletes the object immediately and the dependents in the background.
+	DeletePropagationBackground DeletionPropagation = "Background"
+	// DeletePropagationForeground deletes the dependents first, the object is marked by
+	// FinalizerDeleteDependents until all dependents are deleted.
+	DeletePropagationForeground DeletionPropagation = "Foreground"
+)
+
+// FinalizerDeleteDependents is the finalizer of object deleted in DeletePropagationForeground
+const FinalizerDeleteDependents = "foregroundDeletion"
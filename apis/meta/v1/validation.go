@@ -0,0 +1,61 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package v1
+
+import (
+	"github.com/vine-io/apimachinery/apis/meta/v1/validation"
+	"github.com/vine-io/apimachinery/validation/field"
+)
+
+// ValidateObjectMeta validates the name, namespace, labels and annotations of Meta, the errors are
+// qualified by fldPath, e.g. field.NewPath("metadata"). The name is required unless GenerateName is present.
+func ValidateObjectMeta(m Meta, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if m.GetName() == "" && m.GetGenerateName() == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name or generateName is required"))
+	}
+	if m.GetName() != "" {
+		allErrs = append(allErrs, validation.ValidateName(m.GetName(), false, fldPath.Child("name"))...)
+	}
+	if m.GetGenerateName() != "" {
+		allErrs = append(allErrs, validation.ValidateName(m.GetGenerateName(), true, fldPath.Child("generateName"))...)
+	}
+	if m.GetNamespace() != "" {
+		allErrs = append(allErrs, validation.ValidateNamespace(m.GetNamespace(), fldPath.Child("namespace"))...)
+	}
+	allErrs = append(allErrs, validation.ValidateLabels(m.GetLabels(), fldPath.Child("labels"))...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(m.GetAnnotations(), fldPath.Child("annotations"))...)
+	return allErrs
+}
+
+// ValidateFields implements runtime.FieldValidator, the objects embedding ObjectMeta are validated
+// by runtime.Scheme.Validate.
+func (m *ObjectMeta) ValidateFields(fldPath *field.Path) field.ErrorList {
+	return ValidateObjectMeta(m, fldPath)
+}
+
+// ValidateFields implements runtime.FieldValidator, the objects embedding EntityMeta are validated
+// by runtime.Scheme.Validate.
+func (m *EntityMeta) ValidateFields(fldPath *field.Path) field.ErrorList {
+	return ValidateObjectMeta(m, fldPath)
+}
@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package validation validates the metadata of objects, the errors are qualified by field.Path.
+package validation
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vine-io/apimachinery/validation"
+	"github.com/vine-io/apimachinery/validation/field"
+)
+
+// TotalAnnotationSizeLimitB is the maximum size of all annotations in bytes
+const TotalAnnotationSizeLimitB = 256 * (1 << 10) // 256 kB
+
+// ValidateName validates the name of object as DNS-1123 subdomain. The name is treated as the
+// prefix of generated name when prefix is true, and the trailing '-' is allowed.
+func ValidateName(name string, prefix bool, fldPath *field.Path) field.ErrorList {
+	value := name
+	if prefix {
+		value = strings.TrimSuffix(value, "-")
+		if value == "" {
+			return nil
+		}
+	}
+
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Subdomain(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+	return allErrs
+}
+
+// ValidateNamespace validates the namespace as DNS-1123 label
+func ValidateNamespace(namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Label(namespace) {
+		allErrs = append(allErrs, field.Invalid(fldPath, namespace, msg))
+	}
+	return allErrs
+}
+
+// ValidateLabels validates that the keys of labels are qualified names and the values are valid label values
+func ValidateLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, k := range sortedKeys(labels) {
+		v := labels[k]
+		for _, msg := range validation.IsQualifiedName(k) {
+			allErrs = append(allErrs, field.Invalid(fldPath, k, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(v) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), v, msg))
+		}
+	}
+	return allErrs
+}
+
+// ValidateAnnotations validates that the keys of annotations are qualified names and the total
+// size of annotations doesn't exceed TotalAnnotationSizeLimitB.
+func ValidateAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	var totalSize int64
+	for _, k := range sortedKeys(annotations) {
+		v := annotations[k]
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(k)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, k, msg))
+		}
+		totalSize += int64(len(k)) + int64(len(v))
+	}
+	if totalSize > TotalAnnotationSizeLimitB {
+		allErrs = append(allErrs, field.TooLong(fldPath, "", TotalAnnotationSizeLimitB))
+	}
+	return allErrs
+}
+
+// sortedKeys returns the keys of map in order, so that the errors are reported in stable order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
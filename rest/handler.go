@@ -25,6 +25,7 @@ package rest
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
 )
@@ -71,8 +72,26 @@ func (h *httpHandler) Handler() interface{} {
 	return h.handler
 }
 
+// Endpoints returns the documented paths of handler in the order of url
 func (h *httpHandler) Endpoints() []*pb.OpenAPIPath {
-	return nil
+	urls := make([]string, 0, len(h.opts.Paths))
+	for url := range h.opts.Paths {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	paths := make([]*pb.OpenAPIPath, 0, len(urls))
+	for _, url := range urls {
+		paths = append(paths, h.opts.Paths[url])
+	}
+	return paths
+}
+
+// document adds the OpenAPI documents to the HandlerOptions of handler
+func (h *httpHandler) document(opts ...HandlerOption) {
+	for _, o := range opts {
+		o(&h.opts)
+	}
 }
 
 func (h *httpHandler) Options() HandlerOptions {
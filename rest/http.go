@@ -38,6 +38,12 @@ import (
 	"github.com/gin-gonic/gin"
 	json "github.com/json-iterator/go"
 	"github.com/oxtoacart/bpool"
+	apierrors "github.com/vine-io/apimachinery/apis/errors"
+	"github.com/vine-io/apimachinery/apis/meta"
+	"github.com/vine-io/apimachinery/rest/openapi"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage"
 	log "github.com/vine-io/vine/lib/logger"
 	"github.com/vine-io/vine/util/qson"
 	"go.uber.org/atomic"
@@ -113,7 +119,7 @@ func (h *httpRest) Handle(handler Handler) error {
 	opts := handler.Options()
 	switch opts.Kind {
 	case Resource:
-		err = h.registerResourceHandler(handler.Handler(), opts)
+		err = h.registerResourceHandler(handler, opts)
 	case Http:
 
 	case RPC:
@@ -128,36 +134,93 @@ func (h *httpRest) Handle(handler Handler) error {
 	return nil
 }
 
-func (h *httpRest) registerResourceHandler(handler interface{}, opts HandlerOptions) error {
+// documenter is implemented by the Handler which keeps the OpenAPI documents of its paths
+type documenter interface {
+	document(opts ...HandlerOption)
+}
+
+func (h *httpRest) registerResourceHandler(handler Handler, opts HandlerOptions) error {
 
-	rh := handler.(ResourceHandler)
+	rh := handler.Handler().(ResourceHandler)
 	gvk := opts.Target.Gvk
 	typ := opts.Target.Target
 
-	prefix := path.Join("/api", gvk.Group, gvk.Version, strings.ToLower(gvk.Kind))
-	h.router.GET(prefix, listResourceHandler(rh, typ))
-	h.router.POST(prefix, postResourceHandler(rh))
-	h.router.GET(prefix+"/:uid", getResourceHandler(rh))
-	h.router.PATCH(prefix+"/:uid", patchResourceHandler(rh))
-	h.router.DELETE(prefix+"/:uid", deleteResourceHandler(rh))
+	resource, err := h.resourceFor(gvk)
+	if err != nil {
+		return err
+	}
+
+	prefix := openapi.ResourcePrefix(resource)
+	prefixes := []string{prefix}
+	// keeps serving the path named by the lowercase Kind, which is served before the resource names
+	if legacy := legacyResourcePrefix(gvk); legacy != prefix {
+		prefixes = append(prefixes, legacy)
+	}
+	gr := resource.GroupResource()
+	for _, p := range prefixes {
+		h.router.GET(p, listResourceHandler(rh, gr, typ))
+		h.router.POST(p, postResourceHandler(rh, gr, gvk, typ))
+		h.router.GET(p+"/:uid", getResourceHandler(rh))
+		h.router.PATCH(p+"/:uid", patchResourceHandler(rh))
+		h.router.DELETE(p+"/:uid", deleteResourceHandler(rh))
+	}
+
+	// documents the resource in the HandlerOptions of handler
+	if d, ok := handler.(documenter); ok {
+		g := openapi.NewGenerator()
+		docs := make([]HandlerOption, 0)
+		for url, p := range g.AddResourcePaths(prefix, typ) {
+			docs = append(docs, WithPath(url, p))
+		}
+		for name, model := range g.Models() {
+			docs = append(docs, WithModel(name, model))
+		}
+		d.document(docs...)
+	}
 
 	return nil
 }
 
-func listResourceHandler(rh ResourceHandler, typ reflect.Type) gin.HandlerFunc {
+// resourceFor returns the resource of schema.GroupVersionKind by the meta.RESTMapper in Options,
+// the resource name is guessed from Kind when the meta.RESTMapper is absent.
+func (h *httpRest) resourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	mapper := h.Options().Mapper
+	if mapper == nil {
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		return plural, nil
+	}
+
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return mapping.Resource, nil
+}
+
+// legacyResourcePrefix returns the deprecated path of resource named by the lowercase Kind,
+// e.g. /api/group/v1/pod. It is an alias of openapi.ResourcePrefix and not documented.
+func legacyResourcePrefix(gvk schema.GroupVersionKind) string {
+	return path.Join(openapi.DefaultPrefix, gvk.Group, gvk.Version, strings.ToLower(gvk.Kind))
+}
+
+func listResourceHandler(rh ResourceHandler, gr schema.GroupResource, typ reflect.Type) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
 		_ = typ
 
 		br, err := requestPayload(c.Request)
 		if err != nil {
-			c.JSON(500, fmt.Sprintf("payload: %v", err))
+			writeError(c, gr, "", apierrors.NewBadRequest(fmt.Sprintf("payload: %v", err)))
 			return
 		}
 
 		req := &ListRequest{}
 		if err = json.Unmarshal(br, req); err != nil {
-			c.JSON(500, fmt.Sprintf("unmarshal %v", err))
+			writeError(c, gr, "", apierrors.NewBadRequest(fmt.Sprintf("unmarshal %v", err)))
+			return
+		}
+		if req.Options, err = ParseListOptions(c.Request.URL.Query()); err != nil {
+			writeError(c, gr, "", apierrors.NewBadRequest(fmt.Sprintf("list options: %v", err)))
 			return
 		}
 
@@ -165,6 +228,7 @@ func listResourceHandler(rh ResourceHandler, typ reflect.Type) gin.HandlerFunc {
 
 		err = rh.List(c, req, rsp)
 		if err != nil {
+			writeError(c, gr, "", err)
 			return
 		}
 
@@ -177,9 +241,86 @@ func listResourceHandler(rh ResourceHandler, typ reflect.Type) gin.HandlerFunc {
 	}
 }
 
-func postResourceHandler(rh ResourceHandler) gin.HandlerFunc {
+// writeError writes err as metav1.Status, with the HTTP status code of err. The errors returned
+// by storage are translated by apierrors.FromStorageError, the messages of internal errors are
+// logged instead of being responded to the client.
+func writeError(c *gin.Context, gr schema.GroupResource, name string, err error) {
+	err = apierrors.FromStorageError(err, gr, name)
+	status := apierrors.ErrorToStatus(err)
+	if apierrors.IsInternalError(err) {
+		log.Errorf("%s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+		status.Message = "an internal error occurred"
+		status.Details = nil
+	}
+	c.JSON(apierrors.HTTPStatusCode(err), &status)
+}
+
+func postResourceHandler(rh ResourceHandler, gr schema.GroupResource, gvk schema.GroupVersionKind, typ reflect.Type) gin.HandlerFunc {
 	return func(c *gin.Context) {
+
+		br, err := requestPayload(c.Request)
+		if err != nil {
+			writeError(c, gr, "", apierrors.NewBadRequest(fmt.Sprintf("payload: %v", err)))
+			return
+		}
+
+		if typ == nil || typ.Kind() != reflect.Ptr {
+			writeError(c, gr, "", apierrors.NewInternalError(fmt.Errorf("invalid target %v of %s", typ, gvk)))
+			return
+		}
+		obj, ok := reflect.New(typ.Elem()).Interface().(runtime.Object)
+		if !ok {
+			writeError(c, gr, "", apierrors.NewInternalError(fmt.Errorf("target %v is not a runtime.Object", typ)))
+			return
+		}
+		if err = json.Unmarshal(br, obj); err != nil {
+			writeError(c, gr, "", apierrors.NewBadRequest(fmt.Sprintf("unmarshal %v", err)))
+			return
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+		rsp, err := postObject(c, rh, gr, obj)
+		if err != nil {
+			writeError(c, gr, nameOf(obj), err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"result": rsp.Out,
+		})
+	}
+}
+
+// nameOf returns the name of object, it is empty when the object has no metadata
+func nameOf(obj runtime.Object) string {
+	if m, err := meta.Accessor(obj); err == nil {
+		return m.GetName()
+	}
+	return ""
+}
+
+// postObject posts the object by ResourceHandler. When the Name of object is empty, it is generated
+// from GenerateName and regenerated while ResourceHandler reports AlreadyExists, the errors returned
+// by storage (e.g. unique constraint violation) are translated by apierrors.FromStorageError first.
+func postObject(ctx context.Context, rh ResourceHandler, gr schema.GroupResource, obj runtime.Object) (*PostResponse, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil || m.GetName() != "" || m.GetGenerateName() == "" {
+		rsp := &PostResponse{}
+		return rsp, rh.Post(ctx, &PostRequest{Obj: obj}, rsp)
+	}
+
+	for i := 0; i < storage.MaxNameGenerationAttempts; i++ {
+		in := obj.DeepCopyObject()
+		im, _ := meta.Accessor(in)
+		storage.GenerateName(im)
+
+		rsp := &PostResponse{}
+		err = apierrors.FromStorageError(rh.Post(ctx, &PostRequest{Obj: in}, rsp), gr, im.GetName())
+		if !apierrors.IsAlreadyExists(err) {
+			return rsp, err
+		}
 	}
+	return nil, err
 }
 
 func getResourceHandler(rh ResourceHandler) gin.HandlerFunc {
@@ -0,0 +1,259 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package openapi generates OpenAPI v3 documents from the Go types registered in runtime.Scheme.
+package openapi
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vine-io/apimachinery/runtime"
+	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
+)
+
+// RefPrefix is the prefix of references to component schemas
+const RefPrefix = "#/components/schemas/"
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+
+	invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// Generator walks Go types and collects the OpenAPI v3 component schemas of them
+type Generator struct {
+	mu     sync.RWMutex
+	models map[string]*pb.Model
+}
+
+// NewGenerator creates an empty Generator
+func NewGenerator() *Generator {
+	return &Generator{models: map[string]*pb.Model{}}
+}
+
+// AddScheme adds the models of all types registered in runtime.Scheme
+func (g *Generator) AddScheme(scheme runtime.Scheme) {
+	for _, obj := range scheme.AllObjects() {
+		g.AddType(reflect.TypeOf(obj))
+	}
+}
+
+// AddType adds the model of the given struct type and all the structs it refers to,
+// returns the name of model.
+func (g *Generator) AddType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.addStruct(t)
+}
+
+// Models returns the copy of generated models, the key is the name of model
+func (g *Generator) Models() map[string]*pb.Model {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	out := make(map[string]*pb.Model, len(g.models))
+	for name, model := range g.models {
+		out[name] = model
+	}
+	return out
+}
+
+// ModelName returns the name of component schema for the given type. The name is the
+// import path of package joined with the name of type, e.g. github.com.vine-io.apimachinery.apis.meta.v1.ObjectMeta
+func ModelName(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if pkg := t.PkgPath(); pkg != "" {
+		name = strings.ReplaceAll(pkg, "/", ".") + "." + name
+	}
+	return invalidNameChars.ReplaceAllString(name, "_")
+}
+
+// Ref returns the reference to the component schema of the given type
+func Ref(t reflect.Type) string {
+	return RefPrefix + ModelName(t)
+}
+
+func (g *Generator) addStruct(t reflect.Type) string {
+	name := ModelName(t)
+	if _, ok := g.models[name]; ok {
+		return name
+	}
+
+	model := &pb.Model{Type: "object", Properties: map[string]*pb.Schema{}}
+	// registers model before walking fields, so that the recursive types terminate
+	g.models[name] = model
+	g.addFields(model, t)
+	sort.Strings(model.Required)
+
+	return name
+}
+
+// addFields adds the properties of struct fields to model. The embedded structs without
+// json name are inlined the same as encoding/json does.
+func (g *Generator) addFields(model *pb.Model, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		tag := parseFieldTag(field)
+		if tag.skip {
+			continue
+		}
+
+		ft := field.Type
+		if field.Anonymous && tag.name == "" {
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(model, ft)
+				continue
+			}
+			if !field.IsExported() {
+				continue
+			}
+		}
+
+		name := tag.name
+		if name == "" {
+			name = field.Name
+		}
+
+		var s *pb.Schema
+		if tag.asString {
+			s = &pb.Schema{Type: "string"}
+		} else {
+			s = g.schemaOf(field.Type)
+		}
+		model.Properties[name] = s
+		if tag.required {
+			s.Required = true
+			model.Required = append(model.Required, name)
+		}
+	}
+}
+
+// schemaOf returns the schema of the given type, the struct types are referred by $ref.
+func (g *Generator) schemaOf(t reflect.Type) *pb.Schema {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		nullable = true
+		t = t.Elem()
+	}
+
+	var s *pb.Schema
+	switch {
+	case t == timeType:
+		s = &pb.Schema{Type: "string", Format: "date-time"}
+	case t == bytesType, t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		s = &pb.Schema{Type: "string", Format: "byte"}
+	default:
+		switch t.Kind() {
+		case reflect.Bool:
+			s = &pb.Schema{Type: "boolean"}
+		case reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			s = &pb.Schema{Type: "integer", Format: "int32"}
+		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+			s = &pb.Schema{Type: "integer", Format: "int64"}
+		case reflect.Float32:
+			s = &pb.Schema{Type: "number", Format: "float"}
+		case reflect.Float64:
+			s = &pb.Schema{Type: "number", Format: "double"}
+		case reflect.String:
+			s = &pb.Schema{Type: "string"}
+		case reflect.Slice, reflect.Array:
+			s = &pb.Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+		case reflect.Map:
+			s = &pb.Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+		case reflect.Struct:
+			if t.Name() == "" {
+				// anonymous struct can't be referred by name
+				s = &pb.Schema{Type: "object"}
+			} else {
+				s = &pb.Schema{Ref: RefPrefix + g.addStruct(t)}
+			}
+		default:
+			// interface, e.g. runtime.Object
+			s = &pb.Schema{Type: "object"}
+		}
+	}
+
+	s.Nullable = nullable && s.Ref == ""
+	return s
+}
+
+type fieldTag struct {
+	name     string
+	skip     bool
+	asString bool
+	required bool
+}
+
+// parseFieldTag extracts the property name from json tag, then protobuf tag.
+// The field is required when it's declared as "req" by protobuf tag.
+func parseFieldTag(field reflect.StructField) fieldTag {
+	tag := fieldTag{}
+
+	jsonTag, hasJSON := field.Tag.Lookup("json")
+	if jsonTag == "-" {
+		tag.skip = true
+		return tag
+	}
+	if hasJSON {
+		parts := strings.Split(jsonTag, ",")
+		tag.name = parts[0]
+		for _, opt := range parts[1:] {
+			if opt == "string" {
+				tag.asString = true
+			}
+		}
+	}
+
+	if pbTag, ok := field.Tag.Lookup("protobuf"); ok {
+		for _, part := range strings.Split(pbTag, ",") {
+			switch {
+			case part == "req":
+				tag.required = true
+			case strings.HasPrefix(part, "name=") && !hasJSON:
+				tag.name = strings.TrimPrefix(part, "name=")
+			}
+		}
+	}
+
+	return tag
+}
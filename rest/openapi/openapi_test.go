@@ -0,0 +1,253 @@
+package openapi
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	json "github.com/json-iterator/go"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage/dao"
+	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
+	"gopkg.in/yaml.v3"
+)
+
+var gv = schema.GroupVersion{Group: "test", Version: "v1"}
+
+type Node struct {
+	Name     string  `json:"name"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+type TestSpec struct {
+	Replicas int32  `json:"replicas" protobuf:"varint,1,req,name=replicas"`
+	Raw      []byte `json:"raw"`
+	Count    int64  `json:"count,string"`
+}
+
+type TestObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec     *TestSpec                             `json:"spec,omitempty"`
+	Tags     dao.Array[string]                     `json:"tags"`
+	Refs     dao.JSONArray[*metav1.OwnerReference] `json:"refs"`
+	Weights  dao.Map[string, float64]              `json:"weights"`
+	Created  time.Time                             `json:"created"`
+	Tree     Node                                  `json:"tree"`
+	Proto    string                                `protobuf:"bytes,2,opt,name=proto_name"`
+	Ignored  string                                `json:"-"`
+	Any      interface{}                           `json:"any"`
+	Nullable *int                                  `json:"nullable"`
+}
+
+func (t *TestObj) DeepCopyObject() runtime.Object {
+	out := new(TestObj)
+	*out = *t
+	return out
+}
+
+func (t *TestObj) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestObj)
+}
+
+type TestObjList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []*TestObj `json:"items"`
+}
+
+func (t *TestObjList) DeepCopyObject() runtime.Object {
+	out := new(TestObjList)
+	*out = *t
+	return out
+}
+
+func (t *TestObjList) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestObjList)
+}
+
+func newTestScheme(t *testing.T) runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestObj{}, &TestObjList{}); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func TestModelName(t *testing.T) {
+	want := "github.com.vine-io.apimachinery.apis.meta.v1.ObjectMeta"
+	if got := ModelName(reflect.TypeOf(&metav1.ObjectMeta{})); got != want {
+		t.Errorf("ModelName() = %s, want %s", got, want)
+	}
+}
+
+func TestGenerator_AddType(t *testing.T) {
+	g := NewGenerator()
+	name := g.AddType(reflect.TypeOf(&TestObj{}))
+	models := g.Models()
+
+	model, ok := models[name]
+	if !ok {
+		t.Fatalf("model %s not found", name)
+	}
+
+	tests := []struct {
+		property string
+		want     *pb.Schema
+	}{
+		{"kind", &pb.Schema{Type: "string"}},
+		{"apiVersion", &pb.Schema{Type: "string"}},
+		{"metadata", &pb.Schema{Ref: Ref(reflect.TypeOf(metav1.ObjectMeta{}))}},
+		{"spec", &pb.Schema{Ref: Ref(reflect.TypeOf(TestSpec{}))}},
+		{"tags", &pb.Schema{Type: "array", Items: &pb.Schema{Type: "string"}}},
+		{"refs", &pb.Schema{Type: "array", Items: &pb.Schema{Ref: Ref(reflect.TypeOf(metav1.OwnerReference{}))}}},
+		{"weights", &pb.Schema{Type: "object", AdditionalProperties: &pb.Schema{Type: "number", Format: "double"}}},
+		{"created", &pb.Schema{Type: "string", Format: "date-time"}},
+		{"tree", &pb.Schema{Ref: Ref(reflect.TypeOf(Node{}))}},
+		{"proto_name", &pb.Schema{Type: "string"}},
+		{"any", &pb.Schema{Type: "object"}},
+		{"nullable", &pb.Schema{Type: "integer", Format: "int64", Nullable: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.property, func(t *testing.T) {
+			got, ok := model.Properties[tt.property]
+			if !ok {
+				t.Fatalf("property %s not found", tt.property)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("property %s = %v, want %v", tt.property, got, tt.want)
+			}
+		})
+	}
+
+	if len(model.Properties) != len(tests) {
+		t.Errorf("got %d properties, want %d", len(model.Properties), len(tests))
+	}
+
+	spec := models[ModelName(reflect.TypeOf(TestSpec{}))]
+	if spec == nil {
+		t.Fatal("model of TestSpec not found")
+	}
+	if !reflect.DeepEqual(spec.Required, []string{"replicas"}) {
+		t.Errorf("required = %v", spec.Required)
+	}
+	if got := spec.Properties["raw"]; got.Type != "string" || got.Format != "byte" {
+		t.Errorf("raw = %v", got)
+	}
+	if got := spec.Properties["count"]; got.Type != "string" {
+		t.Errorf("count = %v", got)
+	}
+
+	node := models[ModelName(reflect.TypeOf(Node{}))]
+	if node == nil || node.Properties["children"].Items.Ref != Ref(reflect.TypeOf(Node{})) {
+		t.Errorf("recursive model = %v", node)
+	}
+
+	for _, typ := range []interface{}{metav1.ObjectMeta{}, metav1.OwnerReference{}} {
+		if _, ok := models[ModelName(reflect.TypeOf(typ))]; !ok {
+			t.Errorf("model of %T not found", typ)
+		}
+	}
+}
+
+func TestNewSpec(t *testing.T) {
+	spec, err := NewSpec(newTestScheme(t), nil, &pb.OpenAPIInfo{Title: "test", Version: "v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := spec.Paths["/api/test/v1/testobjs"]; !ok {
+		t.Errorf("collection path not found: %v", spec.Paths)
+	}
+	item, ok := spec.Paths["/api/test/v1/testobjs/{uid}"]
+	if !ok {
+		t.Fatalf("item path not found: %v", spec.Paths)
+	}
+	if item.Get == nil || item.Patch == nil || item.Delete == nil {
+		t.Errorf("item path = %v", item)
+	}
+	post := spec.Paths["/api/test/v1/testobjs"].Post
+	if _, ok := post.Responses["201"]; !ok {
+		t.Errorf("responses of post = %v", post.Responses)
+	}
+
+	// objects are responded in the envelope {"result": ...}
+	name := ModelName(reflect.TypeOf(TestObj{}))
+	envelopes := map[*pb.PathResponse]string{
+		post.Responses["201"]:                                    RefPrefix + name,
+		item.Get.Responses["200"]:                                RefPrefix + name,
+		spec.Paths["/api/test/v1/testobjs"].Get.Responses["200"]: RefPrefix + name + "ListResult",
+	}
+	for rsp, want := range envelopes {
+		envelope := spec.Components.Schemas[strings.TrimPrefix(rsp.Content.ApplicationJson.Schema.Ref, RefPrefix)]
+		if envelope == nil || envelope.Properties["result"].Ref != want {
+			t.Errorf("envelope of %s = %v, want result of %s", rsp.Description, envelope, want)
+		}
+	}
+	statusRef := RefPrefix + ModelName(reflect.TypeOf(metav1.Status{}))
+	for _, code := range []string{"409", "422", "default"} {
+		if rsp, ok := post.Responses[code]; !ok || rsp.Content.ApplicationJson.Schema.Ref != statusRef {
+			t.Errorf("response %s of post = %v", code, rsp)
+		}
+	}
+	if rsp, ok := item.Get.Responses["404"]; !ok || rsp.Content.ApplicationJson.Schema.Ref != statusRef {
+		t.Errorf("response 404 of get = %v", rsp)
+	}
+	if len(spec.Paths) != 2 {
+		t.Errorf("list type should not be served, got paths %v", spec.Paths)
+	}
+
+	for _, typ := range []interface{}{TestObj{}, TestObjList{}, metav1.Status{}} {
+		if _, ok := spec.Components.Schemas[ModelName(reflect.TypeOf(typ))]; !ok {
+			t.Errorf("model of %T not found", typ)
+		}
+	}
+
+	for _, format := range []string{FormatJSON, FormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			buf := bytes.NewBuffer([]byte{})
+			if err := WriteSpec(buf, spec, format); err != nil {
+				t.Fatal(err)
+			}
+
+			out := map[string]interface{}{}
+			if format == FormatJSON {
+				err = json.Unmarshal(buf.Bytes(), &out)
+			} else {
+				err = yaml.Unmarshal(buf.Bytes(), &out)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out["openapi"] != Version {
+				t.Errorf("openapi = %v", out["openapi"])
+			}
+		})
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "openapi.yaml")
+	if err := RunCommand(newTestScheme(t), []string{"-output", output, "-title", "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := map[string]interface{}{}
+	if err = yaml.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := out["info"].(map[string]interface{}); info["title"] != "test" {
+		t.Errorf("info = %v", out["info"])
+	}
+}
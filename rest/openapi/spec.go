@@ -0,0 +1,295 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package openapi
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/vine-io/apimachinery/apis/meta"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Version is the version of OpenAPI specification
+	Version = "3.0.1"
+
+	// DefaultPrefix is the prefix of resource paths served by rest
+	DefaultPrefix = "/api"
+)
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// ResourcePrefix returns the path of resource, e.g. /api/group/v1/pods
+func ResourcePrefix(resource schema.GroupVersionResource) string {
+	return path.Join(DefaultPrefix, resource.Group, resource.Version, resource.Resource)
+}
+
+// AddResourcePaths adds the models of the given resource type and returns the paths
+// served for it under prefix. The objects are responded in the envelope of rest handlers,
+// {"result": {"list": [...], "total": n}} for list and {"result": {...}} for the others.
+func (g *Generator) AddResourcePaths(prefix string, t reflect.Type) map[string]*pb.OpenAPIPath {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := g.AddType(t)
+
+	listName := name + "ListResult"
+	responseName := name + "Response"
+	listResponseName := name + "ListResponse"
+	g.mu.Lock()
+	g.models[listName] = &pb.Model{
+		Type: "object",
+		Properties: map[string]*pb.Schema{
+			"list":  {Type: "array", Items: &pb.Schema{Ref: RefPrefix + name}},
+			"total": {Type: "integer", Format: "int64"},
+		},
+	}
+	g.models[responseName] = resultModel(RefPrefix + name)
+	g.models[listResponseName] = resultModel(RefPrefix + listName)
+	g.mu.Unlock()
+
+	ref := &pb.Schema{Ref: RefPrefix + name}
+	response := &pb.Schema{Ref: RefPrefix + responseName}
+	status := &pb.Schema{Ref: RefPrefix + g.AddType(reflect.TypeOf(metav1.Status{}))}
+
+	kind := t.Name()
+	uid := &pb.PathParameters{In: "path", Name: "uid", Required: true, Schema: &pb.Schema{Type: "string"}}
+	return map[string]*pb.OpenAPIPath{
+		prefix: {
+			Get: &pb.OpenAPIPathDocs{
+				Tags:        []string{kind},
+				Summary:     "list " + kind,
+				OperationId: "List" + kind,
+				Parameters: []*pb.PathParameters{
+					{In: "query", Name: "page", Schema: &pb.Schema{Type: "integer", Format: "int32"}},
+					{In: "query", Name: "size", Schema: &pb.Schema{Type: "integer", Format: "int32"}},
+					{In: "query", Name: "labelSelector", Schema: &pb.Schema{Type: "string"}},
+					{In: "query", Name: "fieldSelector", Schema: &pb.Schema{Type: "string"}},
+					{In: "query", Name: "limit", Schema: &pb.Schema{Type: "integer", Format: "int64"}},
+					{In: "query", Name: "continue", Schema: &pb.Schema{Type: "string"}},
+					{In: "query", Name: "resourceVersion", Schema: &pb.Schema{Type: "string"}},
+					{In: "query", Name: "sortBy", Schema: &pb.Schema{Type: "string"}},
+					{In: "query", Name: "timeoutSeconds", Schema: &pb.Schema{Type: "integer", Format: "int64"}},
+				},
+				Responses: responses(http.StatusOK, jsonResponse("list of "+kind, &pb.Schema{Ref: RefPrefix + listResponseName}), status,
+					http.StatusBadRequest),
+			},
+			Post: &pb.OpenAPIPathDocs{
+				Tags:        []string{kind},
+				Summary:     "create " + kind,
+				OperationId: "Post" + kind,
+				RequestBody: &pb.PathRequestBody{
+					Required: true,
+					Content:  &pb.PathRequestBodyContent{ApplicationJson: &pb.ApplicationContent{Schema: ref}},
+				},
+				Responses: responses(http.StatusCreated, jsonResponse("created "+kind, response), status,
+					http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity),
+			},
+		},
+		prefix + "/{uid}": {
+			Get: &pb.OpenAPIPathDocs{
+				Tags:        []string{kind},
+				Summary:     "get " + kind,
+				OperationId: "Get" + kind,
+				Parameters:  []*pb.PathParameters{uid},
+				Responses:   responses(http.StatusOK, jsonResponse(kind, response), status, http.StatusNotFound),
+			},
+			Patch: &pb.OpenAPIPathDocs{
+				Tags:        []string{kind},
+				Summary:     "patch " + kind,
+				OperationId: "Patch" + kind,
+				Parameters:  []*pb.PathParameters{uid},
+				RequestBody: &pb.PathRequestBody{
+					Required: true,
+					Content:  &pb.PathRequestBodyContent{ApplicationJson: &pb.ApplicationContent{Schema: ref}},
+				},
+				Responses: responses(http.StatusOK, jsonResponse("patched "+kind, response), status,
+					http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity),
+			},
+			Delete: &pb.OpenAPIPathDocs{
+				Tags:        []string{kind},
+				Summary:     "delete " + kind,
+				OperationId: "Delete" + kind,
+				Parameters:  []*pb.PathParameters{uid},
+				Responses:   responses(http.StatusOK, jsonResponse("deleted "+kind, response), status, http.StatusNotFound, http.StatusConflict),
+			},
+		},
+	}
+}
+
+// resultModel returns the model of envelope {"result": ref} responded by rest handlers
+func resultModel(ref string) *pb.Model {
+	return &pb.Model{
+		Type:       "object",
+		Properties: map[string]*pb.Schema{"result": {Ref: ref}},
+	}
+}
+
+// responses returns the responses of an operation. The errors are documented as metav1.Status written
+// with the HTTP status codes of apis/errors, errCodes are the expected ones and "default" covers the others.
+func responses(code int, success *pb.PathResponse, status *pb.Schema, errCodes ...int) map[string]*pb.PathResponse {
+	out := map[string]*pb.PathResponse{
+		strconv.Itoa(code): success,
+		"default":          jsonResponse("unexpected error", status),
+	}
+	for _, c := range errCodes {
+		out[strconv.Itoa(c)] = jsonResponse(http.StatusText(c), status)
+	}
+	return out
+}
+
+func jsonResponse(desc string, s *pb.Schema) *pb.PathResponse {
+	return &pb.PathResponse{
+		Description: desc,
+		Content:     &pb.PathRequestBodyContent{ApplicationJson: &pb.ApplicationContent{Schema: s}},
+	}
+}
+
+// NewSpec generates the OpenAPI document of all types registered in runtime.Scheme. The paths
+// of resources are resolved by meta.RESTMapper, which is created from scheme when nil.
+func NewSpec(scheme runtime.Scheme, mapper meta.RESTMapper, info *pb.OpenAPIInfo) (*pb.OpenAPI, error) {
+	if mapper == nil {
+		mapper = meta.NewRESTMapperFromScheme(scheme)
+	}
+	if info == nil {
+		info = &pb.OpenAPIInfo{}
+	}
+
+	g := NewGenerator()
+	g.AddScheme(scheme)
+
+	objects := scheme.AllObjects()
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].GetObjectKind().GroupVersionKind().String() < objects[j].GetObjectKind().GroupVersionKind().String()
+	})
+
+	paths := map[string]*pb.OpenAPIPath{}
+	for _, obj := range objects {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if strings.HasSuffix(gvk.Kind, "List") && meta.IsListType(obj) {
+			continue
+		}
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("resolve resource of %s: %w", gvk, err)
+		}
+		for url, p := range g.AddResourcePaths(ResourcePrefix(mapping.Resource), reflect.TypeOf(obj)) {
+			paths[url] = p
+		}
+	}
+
+	return &pb.OpenAPI{
+		Openapi:    Version,
+		Info:       info,
+		Paths:      paths,
+		Components: &pb.OpenAPIComponents{Schemas: g.Models()},
+	}, nil
+}
+
+// WriteSpec writes the OpenAPI document to w in the given format, FormatJSON or FormatYAML
+func WriteSpec(w io.Writer, spec *pb.OpenAPI, format string) error {
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case FormatJSON, "":
+		data = append(data, '\n')
+	case FormatYAML:
+		var out any
+		if err = yaml.Unmarshal(data, &out); err != nil {
+			return err
+		}
+		if data, err = yaml.Marshal(out); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// RunCommand parses args as command line flags and writes the OpenAPI document of scheme to disk.
+// It's intended to be called by the main function of a generator program:
+//
+//	func main() {
+//		if err := openapi.RunCommand(scheme, os.Args[1:]); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+func RunCommand(scheme runtime.Scheme, args []string) error {
+	fs := flag.NewFlagSet("openapi", flag.ContinueOnError)
+	output := fs.String("output", "openapi.json", "the file written to, '-' means stdout")
+	format := fs.String("format", "", "the format of output, json or yaml, guessed from the extension of output when empty")
+	title := fs.String("title", "", "the title of API")
+	version := fs.String("version", "", "the version of API")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *format == "" {
+		*format = FormatJSON
+		if ext := path.Ext(*output); ext == ".yaml" || ext == ".yml" {
+			*format = FormatYAML
+		}
+	}
+
+	spec, err := NewSpec(scheme, nil, &pb.OpenAPIInfo{Title: *title, Version: *version})
+	if err != nil {
+		return err
+	}
+
+	if *output == "-" {
+		return WriteSpec(os.Stdout, spec, *format)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err = WriteSpec(f, spec, *format); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -28,6 +28,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/vine-io/apimachinery/apis/meta"
 	"github.com/vine-io/apimachinery/runtime"
 	"github.com/vine-io/apimachinery/schema"
 	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
@@ -44,6 +45,9 @@ type Options struct {
 
 	MaxConn int
 
+	// Mapper maps schema.GroupVersionKind to the resource path
+	Mapper meta.RESTMapper
+
 	// Other options for implementations of the interface
 	// can be stored in a context
 	Context context.Context
@@ -85,6 +89,13 @@ func MaxConn(n int) Option {
 	}
 }
 
+// Mapper sets meta.RESTMapper which maps schema.GroupVersionKind to the resource path
+func Mapper(mapper meta.RESTMapper) Option {
+	return func(o *Options) {
+		o.Mapper = mapper
+	}
+}
+
 // Context specifies a context for the rest.
 // Can be used to signal shutdown of the rest
 // Can be used for extra option values.
@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+// ParseListOptions parses metav1.ListOptions from the query string of list request,
+// e.g. "?labelSelector=app%3Dfoo&fieldSelector=metadata.name%3Dbar&limit=10&sortBy=-metadata.name".
+func ParseListOptions(query url.Values) (*metav1.ListOptions, error) {
+	opts := &metav1.ListOptions{
+		LabelSelector:   query.Get("labelSelector"),
+		FieldSelector:   query.Get("fieldSelector"),
+		Continue:        query.Get("continue"),
+		ResourceVersion: query.Get("resourceVersion"),
+		SortBy:          query.Get("sortBy"),
+	}
+
+	var err error
+	if opts.Limit, err = queryInt64(query, "limit"); err != nil {
+		return nil, err
+	}
+	if opts.TimeoutSeconds, err = queryInt64(query, "timeoutSeconds"); err != nil {
+		return nil, err
+	}
+	if err = opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+func queryInt64(query url.Values, key string) (int64, error) {
+	value := query.Get(key)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", key, value, err)
+	}
+	return n, nil
+}
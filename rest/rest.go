@@ -26,6 +26,7 @@ import (
 	"context"
 	"time"
 
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
 	"github.com/vine-io/apimachinery/runtime"
 	pb "github.com/vine-io/vine/lib/api/handler/openapi/proto"
 )
@@ -92,6 +93,8 @@ type ListRequest struct {
 	Page int32          `json:"page"`
 	Size int32          `json:"size"`
 	Ps   runtime.Object `json:"ps"`
+	// Options is parsed from the query string, it's passed to storage.List
+	Options *metav1.ListOptions `json:"-"`
 }
 
 type ListResponse struct {
@@ -99,6 +102,9 @@ type ListResponse struct {
 	Total int64
 }
 
+// PostRequest holds the object to create, the Name of object is generated from GenerateName
+// before handled. The handler is expected to report AlreadyExists on the name collision, so that
+// another name is generated, storage.Create does the same for the callers outside REST.
 type PostRequest struct {
 	Obj runtime.Object
 }
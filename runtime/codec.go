@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"io"
+)
+
+// Encoder writes objects to a serialized form
+type Encoder interface {
+	// Encode writes an object to a stream, the TypeMeta of the object is filled by the Scheme
+	// when it is empty.
+	Encode(obj Object, w io.Writer) error
+}
+
+// Decoder attempts to load an object from data.
+type Decoder interface {
+	// Decode reads the apiVersion and kind from data and decodes it into a new Object created by Scheme.
+	// If into is not nil, it will be used as the target type.
+	Decode(data []byte, into Object) (Object, error)
+}
+
+// Codec is a Serializer that deals with the details of versioning objects.
+type Codec interface {
+	Encoder
+	Decoder
+}
+
+// StreamCodec is a Codec which handles a stream contains multiple objects, e.g. multi-document YAML.
+type StreamCodec interface {
+	Codec
+
+	// EncodeAll writes all objects into one stream
+	EncodeAll(w io.Writer, objects ...Object) error
+
+	// DecodeAll decodes all objects in data
+	DecodeAll(data []byte) ([]Object, error)
+}
+
+// SerializerInfo contains information about a specific serialization format
+type SerializerInfo struct {
+	// MediaType is the value that represents this serializer over the wire.
+	MediaType string
+	// Codec is the codec of this MediaType
+	Codec Codec
+}
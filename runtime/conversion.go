@@ -0,0 +1,169 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vine-io/apimachinery/schema"
+)
+
+// ConversionFunc converts in to out. out is a new object of the target version which
+// has already been filled with the fields copied by automatic conversion.
+type ConversionFunc func(in, out Object) error
+
+type typePair struct {
+	src reflect.Type
+	dst reflect.Type
+}
+
+// AddConversionFunc adds ConversionFunc which converts src type to dst type
+func (s *SimpleScheme) AddConversionFunc(src, dst Object, fn ConversionFunc) error {
+	srcType := reflect.TypeOf(src)
+	dstType := reflect.TypeOf(dst)
+	if srcType.Kind() != reflect.Ptr || dstType.Kind() != reflect.Ptr {
+		return ErrIsNotPointer
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+
+	s.conversionFuncs[typePair{src: srcType.Elem(), dst: dstType.Elem()}] = fn
+	return nil
+}
+
+// Convert converts in to the same Kind of the given schema.GroupVersion. Fields with identical
+// names are copied automatically, then the ConversionFunc registered for the pair is called.
+func (s *SimpleScheme) Convert(in Object, gv schema.GroupVersion) (Object, error) {
+	gvk, err := s.ObjectKind(in)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %T", err, in)
+	}
+	srcType := reflect.TypeOf(in).Elem()
+
+	target := gv.WithKind(gvk.Kind)
+	s.mu.RLock()
+	dstType, exists := s.gvkToTypes[target]
+	fn, hasFn := s.conversionFuncs[typePair{src: srcType, dst: dstType}]
+	s.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownGVK, target)
+	}
+
+	src := in.DeepCopyObject()
+	out := reflect.New(dstType).Interface().(Object)
+	if dstType == srcType {
+		out = src
+	} else {
+		convertValue(reflect.ValueOf(src).Elem(), reflect.ValueOf(out).Elem())
+
+		if hasFn {
+			if err := fn(src, out); err != nil {
+				return nil, fmt.Errorf("convert %s to %s: %w", gvk, target, err)
+			}
+		}
+	}
+
+	out.GetObjectKind().SetGroupVersionKind(target)
+	return out, nil
+}
+
+// convertValue copies src into dst recursively. Struct fields are matched by name,
+// values which can not be converted are left untouched and reported by false.
+func convertValue(src, dst reflect.Value) bool {
+	st, dt := src.Type(), dst.Type()
+	if st == dt {
+		dst.Set(src)
+		return true
+	}
+
+	if st.Kind() != dt.Kind() {
+		return false
+	}
+
+	switch st.Kind() {
+	case reflect.Struct:
+		for i := 0; i < dt.NumField(); i++ {
+			fd := dt.Field(i)
+			if !fd.IsExported() {
+				continue
+			}
+			sf, ok := st.FieldByName(fd.Name)
+			if !ok || !sf.IsExported() || len(sf.Index) != 1 {
+				continue
+			}
+			convertValue(src.Field(sf.Index[0]), dst.Field(i))
+		}
+		return true
+	case reflect.Ptr:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dt))
+			return true
+		}
+		out := reflect.New(dt.Elem())
+		if !convertValue(src.Elem(), out.Elem()) {
+			return false
+		}
+		dst.Set(out)
+		return true
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dt))
+			return true
+		}
+		out := reflect.MakeSlice(dt, src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			if !convertValue(src.Index(i), out.Index(i)) {
+				return false
+			}
+		}
+		dst.Set(out)
+		return true
+	case reflect.Map:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dt))
+			return true
+		}
+		out := reflect.MakeMapWithSize(dt, src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			key := reflect.New(dt.Key()).Elem()
+			value := reflect.New(dt.Elem()).Elem()
+			if !convertValue(iter.Key(), key) || !convertValue(iter.Value(), value) {
+				return false
+			}
+			out.SetMapIndex(key, value)
+		}
+		dst.Set(out)
+		return true
+	case reflect.Interface, reflect.Array, reflect.Chan, reflect.Func:
+		return false
+	default:
+		dst.Set(src.Convert(dt))
+		return true
+	}
+}
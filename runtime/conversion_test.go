@@ -0,0 +1,186 @@
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+type TestConvert struct {
+	metav1.TypeMeta
+	Name     string
+	Replicas string
+	Labels   map[string]string
+	Spec     *TestConvertSpec
+}
+
+type TestConvertSpec struct {
+	Image string
+}
+
+func (t *TestConvert) DeepCopyObject() Object {
+	out := new(TestConvert)
+	*out = *t
+	if t.Labels != nil {
+		out.Labels = make(map[string]string, len(t.Labels))
+		for k, v := range t.Labels {
+			out.Labels[k] = v
+		}
+	}
+	if t.Spec != nil {
+		out.Spec = new(TestConvertSpec)
+		*out.Spec = *t.Spec
+	}
+	return out
+}
+
+func (t *TestConvert) DeepFromObject(o Object) {
+	*t = *o.DeepCopyObject().(*TestConvert)
+}
+
+var _ (Object) = (*TestConvert)(nil)
+
+type testConvertV2 struct {
+	metav1.TypeMeta
+	Name     string
+	Replicas int32
+	Labels   map[string]string
+	Spec     *testConvertSpecV2
+}
+
+type testConvertSpecV2 struct {
+	Image string
+	Pull  bool
+}
+
+func (t *testConvertV2) DeepCopyObject() Object {
+	out := new(testConvertV2)
+	*out = *t
+	if t.Labels != nil {
+		out.Labels = make(map[string]string, len(t.Labels))
+		for k, v := range t.Labels {
+			out.Labels[k] = v
+		}
+	}
+	if t.Spec != nil {
+		out.Spec = new(testConvertSpecV2)
+		*out.Spec = *t.Spec
+	}
+	return out
+}
+
+func (t *testConvertV2) DeepFromObject(o Object) {
+	*t = *o.DeepCopyObject().(*testConvertV2)
+}
+
+var (
+	convertV1 = schema.GroupVersion{Group: "test", Version: "v1"}
+	convertV2 = schema.GroupVersion{Group: "test", Version: "v2"}
+)
+
+// newConvertScheme registers TestConvert in v1 and testConvertV2 as the same Kind in v2
+func newConvertScheme(t *testing.T) *SimpleScheme {
+	scheme := NewScheme()
+	if err := scheme.AddKnownTypes(convertV1, &TestConvert{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := scheme.AddKnownTypeWithName(convertV2.WithKind("TestConvert"), &testConvertV2{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := scheme.AddConversionFunc(&TestConvert{}, &testConvertV2{}, func(in, out Object) error {
+		n, err := strconv.Atoi(in.(*TestConvert).Replicas)
+		if err != nil {
+			return err
+		}
+		out.(*testConvertV2).Replicas = int32(n)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = scheme.AddConversionFunc(&testConvertV2{}, &TestConvert{}, func(in, out Object) error {
+		out.(*TestConvert).Replicas = strconv.Itoa(int(in.(*testConvertV2).Replicas))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func TestSimpleScheme_Convert(t *testing.T) {
+	scheme := newConvertScheme(t)
+
+	in := &TestConvert{Name: "c1", Replicas: "3", Labels: map[string]string{"a": "b"}, Spec: &TestConvertSpec{Image: "nginx"}}
+	out, err := scheme.Convert(in, convertV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, ok := out.(*testConvertV2)
+	if !ok {
+		t.Fatalf("unexpected type %T", out)
+	}
+	if o.Name != "c1" || o.Replicas != 3 || o.Labels["a"] != "b" || o.Spec == nil || o.Spec.Image != "nginx" {
+		t.Fatalf("unexpected result: %#v", o)
+	}
+	if o.GroupVersionKind() != convertV2.WithKind("TestConvert") {
+		t.Fatalf("unexpected gvk: %v", o.GroupVersionKind())
+	}
+
+	o.Labels["a"] = "c"
+	if in.Labels["a"] != "b" {
+		t.Fatal("converted object shares map with source")
+	}
+
+	// round trip
+	back, err := scheme.Convert(o, convertV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := back.(*TestConvert); b.Name != "c1" || b.Replicas != "3" || b.Labels["a"] != "c" || b.Spec.Image != "nginx" {
+		t.Fatalf("unexpected result: %#v", b)
+	}
+
+	// the same version is deep copied
+	same, err := scheme.Convert(in, convertV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same == Object(in) || !reflect.DeepEqual(same.(*TestConvert).Labels, in.Labels) {
+		t.Fatalf("unexpected result: %#v", same)
+	}
+}
+
+func TestSimpleScheme_ConvertErrors(t *testing.T) {
+	scheme := newConvertScheme(t)
+
+	tests := []struct {
+		name string
+		in   Object
+		gv   schema.GroupVersion
+		want error
+	}{
+		{name: "conversion func", in: &TestConvert{Replicas: "x"}, gv: convertV2, want: strconv.ErrSyntax},
+		{name: "unknown version", in: &TestConvert{}, gv: schema.GroupVersion{Group: "test", Version: "v3"}, want: ErrUnknownGVK},
+		{name: "unregistered type", in: &TestObj{}, gv: convertV2, want: ErrUnknownType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := scheme.Convert(tt.in, tt.gv); !errors.Is(err, tt.want) {
+				t.Fatalf("Convert() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	scheme.Freeze()
+	fn := func(in, out Object) error { return nil }
+	if err := scheme.AddConversionFunc(&TestConvert{}, &testConvertV2{}, fn); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected ErrSchemeFrozen, got %v", err)
+	}
+}
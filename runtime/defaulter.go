@@ -0,0 +1,231 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	json "github.com/json-iterator/go"
+)
+
+// defaultTag is the struct tag declares the default value of field, e.g.
+//
+//	Replicas int32             `json:"replicas" default:"3"`
+//	Ports    []int32           `json:"ports" default:"[80, 443]"`
+//	Labels   dao.Map[K, V]     `json:"labels" default:"{}"`
+const defaultTag = "default"
+
+// defaultPlans caches the defaultPlan of reflect.Type
+var defaultPlans sync.Map
+
+// defaultPlan is the defaulting steps of a struct type
+type defaultPlan struct {
+	fields []defaultField
+}
+
+type defaultField struct {
+	index int
+	// value sets the default value to the field when it is zero, it is nil when field has no default tag
+	value func(v reflect.Value)
+	// nested is true when the field (or its elements) contains structs to be defaulted
+	nested bool
+}
+
+// applyTagDefaults sets the default values declared by struct tags of v recursively. The struct
+// types whose default tags are malformed are skipped, the errors are returned by checkTagDefaults
+// when the types are registered.
+func applyTagDefaults(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			applyTagDefaults(v.Elem())
+		}
+	case reflect.Struct:
+		plan, err := defaultPlanFor(v.Type())
+		if err != nil {
+			return
+		}
+		for _, fd := range plan.fields {
+			fv := v.Field(fd.index)
+			if fd.value != nil && fv.IsZero() {
+				fd.value(fv)
+			}
+			if fd.nested {
+				applyTagDefaults(fv)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			applyTagDefaults(v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			// map value is not addressable, defaults a copy and sets it back
+			item := reflect.New(iter.Value().Type()).Elem()
+			item.Set(iter.Value())
+			applyTagDefaults(item)
+			v.SetMapIndex(iter.Key(), item)
+		}
+	}
+}
+
+// checkTagDefaults parses the default tags of the type and all struct types it contains
+func checkTagDefaults(rt reflect.Type) error {
+	return checkNestedDefaults(rt, map[reflect.Type]struct{}{})
+}
+
+func checkNestedDefaults(rt reflect.Type, visited map[reflect.Type]struct{}) error {
+	switch rt.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return checkNestedDefaults(rt.Elem(), visited)
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	if _, ok := visited[rt]; ok {
+		return nil
+	}
+	visited[rt] = struct{}{}
+
+	if _, err := defaultPlanFor(rt); err != nil {
+		return err
+	}
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		if err := checkNestedDefaults(sf.Type, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultPlanFor returns the cached defaultPlan, or the error when the default tag is malformed
+func defaultPlanFor(rt reflect.Type) (*defaultPlan, error) {
+	if v, ok := defaultPlans.Load(rt); ok {
+		return v.(*defaultPlan), nil
+	}
+
+	plan := &defaultPlan{fields: make([]defaultField, 0)}
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fd := defaultField{index: i, nested: hasNestedStruct(sf.Type)}
+		if tag, ok := sf.Tag.Lookup(defaultTag); ok {
+			fn, err := defaultValueFunc(sf.Type, tag)
+			if err != nil {
+				return nil, fmt.Errorf("%w of %v.%s: %v", ErrInvalidDefaultTag, rt, sf.Name, err)
+			}
+			fd.value = fn
+		}
+
+		if fd.value != nil || fd.nested {
+			plan.fields = append(plan.fields, fd)
+		}
+	}
+
+	v, _ := defaultPlans.LoadOrStore(rt, plan)
+	return v.(*defaultPlan), nil
+}
+
+// hasNestedStruct checks whether the values of type contain struct
+func hasNestedStruct(rt reflect.Type) bool {
+	switch rt.Kind() {
+	case reflect.Struct:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return hasNestedStruct(rt.Elem())
+	default:
+		return false
+	}
+}
+
+// defaultValueFunc parses the default tag, returns a function sets the value to field
+func defaultValueFunc(rt reflect.Type, tag string) (func(v reflect.Value), error) {
+	switch rt.Kind() {
+	case reflect.Ptr:
+		elem, err := defaultValueFunc(rt.Elem(), tag)
+		if err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) {
+			out := reflect.New(rt.Elem())
+			elem(out.Elem())
+			v.Set(out)
+		}, nil
+	case reflect.String:
+		value := reflect.ValueOf(tag).Convert(rt)
+		return func(v reflect.Value) { v.Set(value) }, nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(tag)
+		if err != nil {
+			return nil, err
+		}
+		value := reflect.ValueOf(b).Convert(rt)
+		return func(v reflect.Value) { v.Set(value) }, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(tag, 0, rt.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value := reflect.ValueOf(n).Convert(rt)
+		return func(v reflect.Value) { v.Set(value) }, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(tag, 0, rt.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value := reflect.ValueOf(n).Convert(rt)
+		return func(v reflect.Value) { v.Set(value) }, nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(tag, rt.Bits())
+		if err != nil {
+			return nil, err
+		}
+		value := reflect.ValueOf(f).Convert(rt)
+		return func(v reflect.Value) { v.Set(value) }, nil
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		// checks the tag once, composite values are decoded every time to avoid sharing
+		data := []byte(tag)
+		if err := json.Unmarshal(data, reflect.New(rt).Interface()); err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) {
+			out := reflect.New(rt)
+			_ = json.Unmarshal(data, out.Interface())
+			v.Set(out.Elem())
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %v", rt.Kind())
+	}
+}
@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage/dao"
+)
+
+type TestDefaultSpec struct {
+	Image   string `default:"nginx"`
+	Enabled *bool  `default:"true"`
+}
+
+type TestDefaultObj struct {
+	TestObj
+	Replicas int32                   `default:"3"`
+	Ratio    float64                 `default:"0.5"`
+	Ports    []int32                 `default:"[]"`
+	Hosts    dao.Array[string]       `default:"[\"localhost\"]"`
+	Labels   dao.Map[string, string] `default:"{}"`
+	Spec     *TestDefaultSpec        `default:"{}"`
+	Template TestDefaultSpec
+	Items    []*TestDefaultSpec
+	Named    map[string]TestDefaultSpec
+}
+
+func TestSimpleScheme_DefaultTag(t *testing.T) {
+	scheme := NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestDefaultObj{}); err != nil {
+		t.Fatal(err)
+	}
+	scheme.AddTypeDefaultingFunc(&TestDefaultObj{}, func(src Object, gvk schema.GroupVersionKind) Object {
+		// DefaultFunc runs after the default tags
+		src.(*TestDefaultObj).Replicas++
+		return src
+	})
+
+	obj := &TestDefaultObj{
+		Ratio: 1,
+		Items: []*TestDefaultSpec{{Image: "redis"}, nil},
+		Named: map[string]TestDefaultSpec{"a": {}},
+	}
+	scheme.Default(obj)
+
+	enabled := true
+	want := &TestDefaultObj{
+		Replicas: 4,
+		Ratio:    1,
+		Ports:    []int32{},
+		Hosts:    dao.Array[string]{"localhost"},
+		Labels:   dao.Map[string, string]{},
+		Spec:     &TestDefaultSpec{Image: "nginx", Enabled: &enabled},
+		Template: TestDefaultSpec{Image: "nginx", Enabled: &enabled},
+		Items:    []*TestDefaultSpec{{Image: "redis", Enabled: &enabled}, nil},
+		Named:    map[string]TestDefaultSpec{"a": {Image: "nginx", Enabled: &enabled}},
+	}
+	want.SetGroupVersionKind(gv.WithKind("TestDefaultObj"))
+	if !reflect.DeepEqual(obj, want) {
+		t.Fatalf("Default() = %#v, want %#v", *obj, *want)
+	}
+
+	other := &TestDefaultObj{}
+	scheme.Default(other)
+	other.Hosts[0] = "example.com"
+	if obj.Hosts[0] != "localhost" {
+		t.Fatal("default values are shared between objects")
+	}
+}
+
+type TestBadDefaultSpec struct {
+	Replicas int32 `default:"three"`
+}
+
+type TestBadDefaultObj struct {
+	TestObj
+	Spec []TestBadDefaultSpec
+}
+
+func TestSimpleScheme_InvalidDefaultTag(t *testing.T) {
+	scheme := NewScheme()
+	err := scheme.AddKnownTypes(gv, &TestBadDefaultObj{})
+	if !errors.Is(err, ErrInvalidDefaultTag) {
+		t.Fatalf("expected ErrInvalidDefaultTag, got %v", err)
+	}
+	if scheme.IsExists(gv.WithKind("TestBadDefaultObj")) {
+		t.Fatal("type with invalid default tag registered")
+	}
+
+	// the malformed tags are skipped without panic
+	obj := &TestBadDefaultObj{Spec: []TestBadDefaultSpec{{}}}
+	scheme.Default(obj)
+	if obj.Spec[0].Replicas != 0 {
+		t.Fatalf("malformed default tag applied: %v", obj.Spec[0].Replicas)
+	}
+}
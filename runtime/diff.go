@@ -0,0 +1,266 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+)
+
+// ChangeType describes how a field changes
+type ChangeType string
+
+const (
+	FieldAdded    ChangeType = "add"
+	FieldRemoved  ChangeType = "remove"
+	FieldModified ChangeType = "replace"
+)
+
+// ServerManagedFields are the fields of ObjectMeta maintained by server, which are
+// ignored by IgnoreServerManagedFields.
+var ServerManagedFields = [][]string{
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "updateTimestamp"},
+	{"metadata", "deletionTimestamp"},
+}
+
+// FieldChange is a change of field between two objects
+type FieldChange struct {
+	Type ChangeType `json:"type"`
+	// Path is JSON Pointer (RFC 6901) of field, e.g. /metadata/labels/app
+	Path string `json:"path"`
+	// Old is the value before change, nil when the field is added
+	Old any `json:"old,omitempty"`
+	// New is the value after change, nil when the field is removed
+	New any `json:"new,omitempty"`
+}
+
+func (c FieldChange) String() string {
+	switch c.Type {
+	case FieldAdded:
+		return fmt.Sprintf("%s %s: %v", c.Type, c.Path, c.New)
+	case FieldRemoved:
+		return fmt.Sprintf("%s %s: %v", c.Type, c.Path, c.Old)
+	default:
+		return fmt.Sprintf("%s %s: %v -> %v", c.Type, c.Path, c.Old, c.New)
+	}
+}
+
+type diffOptions struct {
+	ignored [][]string
+}
+
+// DiffOption customizes Diff, CreateJSONPatch and CreateMergePatch
+type DiffOption func(*diffOptions)
+
+// IgnoreField ignores the nested field, e.g. IgnoreField("metadata", "labels")
+func IgnoreField(fields ...string) DiffOption {
+	return func(o *diffOptions) {
+		if len(fields) != 0 {
+			o.ignored = append(o.ignored, fields)
+		}
+	}
+}
+
+// IgnoreServerManagedFields ignores ServerManagedFields
+func IgnoreServerManagedFields() DiffOption {
+	return func(o *diffOptions) {
+		o.ignored = append(o.ignored, ServerManagedFields...)
+	}
+}
+
+// Diff returns the changes of fields from a to b in JSON representation. The changes are
+// ordered by path, the elements of arrays are compared by index.
+func Diff(a, b Object, opts ...DiffOption) ([]FieldChange, error) {
+	am, bm, err := diffMaps(a, b, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]FieldChange, 0)
+	diffValue("", am, bm, &changes)
+	return changes, nil
+}
+
+// CreateJSONPatch returns JSON Patch (RFC 6902) document which transforms a to b
+func CreateJSONPatch(a, b Object, opts ...DiffOption) ([]byte, error) {
+	changes, err := Diff(a, b, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]map[string]any, 0, len(changes))
+	for _, change := range changes {
+		op := map[string]any{"op": change.Type, "path": change.Path}
+		if change.Type != FieldRemoved {
+			op["value"] = change.New
+		}
+		ops = append(ops, op)
+	}
+
+	return json.Marshal(ops)
+}
+
+// CreateMergePatch returns JSON Merge Patch (RFC 7386) document which transforms a to b.
+// Arrays are replaced as a whole as required by RFC 7386.
+func CreateMergePatch(a, b Object, opts ...DiffOption) ([]byte, error) {
+	am, bm, err := diffMaps(a, b, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(am, bm))
+}
+
+// diffMaps converts objects to JSON representations, the ignored fields are removed.
+func diffMaps(a, b Object, opts ...DiffOption) (map[string]any, map[string]any, error) {
+	if a == nil || b == nil {
+		return nil, nil, fmt.Errorf("diff nil object")
+	}
+
+	options := diffOptions{}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	out := make([]map[string]any, 0, 2)
+	for _, obj := range []Object{a, b} {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err := unmarshalMap(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, fields := range options.ignored {
+			RemoveNestedField(m, fields...)
+		}
+		out = append(out, m)
+	}
+
+	return out[0], out[1], nil
+}
+
+func diffValue(path string, a, b any, changes *[]FieldChange) {
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			diffMap(path, av, bv, changes)
+			return
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			diffSlice(path, av, bv, changes)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, FieldChange{Type: FieldModified, Path: path, Old: a, New: b})
+	}
+}
+
+func diffMap(path string, a, b map[string]any, changes *[]FieldChange) {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		child := path + "/" + escapePointer(k)
+		av, inA := a[k]
+		bv, inB := b[k]
+		switch {
+		case !inA:
+			*changes = append(*changes, FieldChange{Type: FieldAdded, Path: child, New: bv})
+		case !inB:
+			*changes = append(*changes, FieldChange{Type: FieldRemoved, Path: child, Old: av})
+		default:
+			diffValue(child, av, bv, changes)
+		}
+	}
+}
+
+func diffSlice(path string, a, b []any, changes *[]FieldChange) {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		diffValue(path+"/"+strconv.Itoa(i), a[i], b[i], changes)
+	}
+	for i := n; i < len(b); i++ {
+		*changes = append(*changes, FieldChange{Type: FieldAdded, Path: path + "/" + strconv.Itoa(i), New: b[i]})
+	}
+	// removes from the tail, so that the indexes of patch stay valid
+	for i := len(a) - 1; i >= n; i-- {
+		*changes = append(*changes, FieldChange{Type: FieldRemoved, Path: path + "/" + strconv.Itoa(i), Old: a[i]})
+	}
+}
+
+func mergePatch(a, b map[string]any) map[string]any {
+	patch := map[string]any{}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok {
+			patch[k] = nil
+			continue
+		}
+		am, aIsMap := av.(map[string]any)
+		bm, bIsMap := bv.(map[string]any)
+		if aIsMap && bIsMap {
+			if sub := mergePatch(am, bm); len(sub) != 0 {
+				patch[k] = sub
+			}
+			continue
+		}
+		if !reflect.DeepEqual(av, bv) {
+			patch[k] = bv
+		}
+	}
+	for k, bv := range b {
+		if _, ok := a[k]; !ok {
+			patch[k] = bv
+		}
+	}
+	return patch
+}
+
+// escapePointer escapes the reference token of JSON Pointer
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,147 @@
+package runtime
+
+import (
+	"reflect"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	json "github.com/json-iterator/go"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+func newDiffObjs() (*TestMetaObj, *TestMetaObj) {
+	a := &TestMetaObj{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "o1",
+			ResourceVersion: "1",
+			UpdateTimestamp: 100,
+			Labels:          map[string]string{"a": "b", "x/y": "z"},
+			References: []*metav1.OwnerReference{
+				{Kind: "Owner", Name: "p1"},
+				{Kind: "Owner", Name: "p2"},
+			},
+		},
+		Replicas: 1,
+	}
+
+	b := a.DeepCopyObject().(*TestMetaObj)
+	b.ResourceVersion = "2"
+	b.UpdateTimestamp = 200
+	b.Labels = map[string]string{"a": "c", "d": "e"}
+	b.References = b.References[:1]
+	b.Replicas = 3
+
+	return a, b
+}
+
+func TestDiff(t *testing.T) {
+	a, b := newDiffObjs()
+
+	tests := []struct {
+		name string
+		opts []DiffOption
+		want []FieldChange
+	}{
+		{
+			name: "all",
+			want: []FieldChange{
+				{Type: FieldModified, Path: "/metadata/labels/a", Old: "b", New: "c"},
+				{Type: FieldAdded, Path: "/metadata/labels/d", New: "e"},
+				{Type: FieldRemoved, Path: "/metadata/labels/x~1y", Old: "z"},
+				{Type: FieldRemoved, Path: "/metadata/references/1", Old: map[string]any{"kind": "Owner", "name": "p2"}},
+				{Type: FieldModified, Path: "/metadata/resourceVersion", Old: "1", New: "2"},
+				{Type: FieldModified, Path: "/metadata/updateTimestamp", Old: int64(100), New: int64(200)},
+				{Type: FieldModified, Path: "/replicas", Old: int64(1), New: int64(3)},
+			},
+		},
+		{
+			name: "ignore server managed fields",
+			opts: []DiffOption{IgnoreServerManagedFields(), IgnoreField("metadata", "labels")},
+			want: []FieldChange{
+				{Type: FieldRemoved, Path: "/metadata/references/1", Old: map[string]any{"kind": "Owner", "name": "p2"}},
+				{Type: FieldModified, Path: "/replicas", Old: int64(1), New: int64(3)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Diff(a, b, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(changes, tt.want) {
+				t.Errorf("Diff() = %v, want %v", changes, tt.want)
+			}
+		})
+	}
+
+	changes, err := Diff(a, a.DeepCopyObject())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Diff() of equal objects = %v", changes)
+	}
+}
+
+func TestCreatePatch(t *testing.T) {
+	a, b := newDiffObjs()
+	original, _ := json.Marshal(a)
+	modified, _ := json.Marshal(b)
+
+	tests := []struct {
+		name  string
+		patch func(a, b Object, opts ...DiffOption) ([]byte, error)
+		apply func(doc, patch []byte) ([]byte, error)
+	}{
+		{
+			name:  "json patch",
+			patch: CreateJSONPatch,
+			apply: func(doc, data []byte) ([]byte, error) {
+				patch, err := jsonpatch.DecodePatch(data)
+				if err != nil {
+					return nil, err
+				}
+				return patch.Apply(doc)
+			},
+		},
+		{
+			name:  "merge patch",
+			patch: CreateMergePatch,
+			apply: jsonpatch.MergePatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := tt.patch(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := tt.apply(original, patch)
+			if err != nil {
+				t.Fatalf("apply %s: %v", patch, err)
+			}
+			if !jsonpatch.Equal(out, modified) {
+				t.Errorf("patched %s, want %s", out, modified)
+			}
+
+			patch, err = tt.patch(a, b, IgnoreServerManagedFields())
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err = tt.apply(original, patch)
+			if err != nil {
+				t.Fatalf("apply %s: %v", patch, err)
+			}
+			obj := &TestMetaObj{}
+			if err = json.Unmarshal(out, obj); err != nil {
+				t.Fatal(err)
+			}
+			if obj.ResourceVersion != a.ResourceVersion || obj.UpdateTimestamp != a.UpdateTimestamp || obj.Replicas != b.Replicas {
+				t.Errorf("server managed fields are patched: %s", patch)
+			}
+		})
+	}
+}
@@ -22,10 +22,36 @@
 
 package runtime
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/vine-io/apimachinery/schema"
+)
 
 var (
 	ErrIsNotPointer = fmt.Errorf("object is not a pointer")
 	ErrUnknownGVK   = fmt.Errorf("unknown GroupVersionKind")
 	ErrUnknownType  = fmt.Errorf("unknown type")
+	ErrSchemeFrozen = fmt.Errorf("scheme is frozen")
+
+	ErrInvalidDefaultTag = fmt.Errorf("invalid default tag")
 )
+
+// RegistrationConflictError is returned when two types claim the same schema.GroupVersionKind
+type RegistrationConflictError struct {
+	GVK      schema.GroupVersionKind
+	Existing reflect.Type
+	New      reflect.Type
+}
+
+func (e *RegistrationConflictError) Error() string {
+	return fmt.Sprintf("double registration of different types for %v: old=%v, new=%v", e.GVK, e.Existing, e.New)
+}
+
+// IsRegistrationConflict checks whether the error is RegistrationConflictError
+func IsRegistrationConflict(err error) bool {
+	var target *RegistrationConflictError
+	return errors.As(err, &target)
+}
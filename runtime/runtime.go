@@ -24,6 +24,7 @@ package runtime
 
 import (
 	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/validation/field"
 )
 
 // Object interface must be supported by all API types registered with Scheme. Since objects in a scheme are
@@ -42,9 +43,28 @@ type Scheme interface {
 	// New creates a new object
 	New(gvk schema.GroupVersionKind) (Object, error)
 
+	// NewList creates a new list object of the given item schema.GroupVersionKind
+	NewList(gvk schema.GroupVersionKind) (Object, error)
+
+	// ListKind returns the list schema.GroupVersionKind of the given item schema.GroupVersionKind
+	ListKind(gvk schema.GroupVersionKind) (schema.GroupVersionKind, bool)
+
 	// AllGVKs returns all schema.GroupVersionKind
 	AllGVKs() []schema.GroupVersionKind
 
+	// ObjectKind returns the schema.GroupVersionKind of the given Object
+	ObjectKind(obj Object) (schema.GroupVersionKind, error)
+
+	// ObjectKinds returns all schema.GroupVersionKind registered of the given Object
+	ObjectKinds(obj Object) ([]schema.GroupVersionKind, error)
+
+	// PrioritizedVersionsAllGroups returns all registered schema.GroupVersion in priority order
+	PrioritizedVersionsAllGroups() []schema.GroupVersion
+	// PrioritizedVersionsForGroup returns the registered schema.GroupVersion of group in priority order
+	PrioritizedVersionsForGroup(group string) []schema.GroupVersion
+	// PreferredVersion returns the schema.GroupVersion of group with the highest priority
+	PreferredVersion(group string) (schema.GroupVersion, bool)
+
 	// AllObjects returns all Objects
 	AllObjects() []Object
 
@@ -54,12 +74,33 @@ type Scheme interface {
 	// AddKnownTypes adds Objects to Machinery
 	AddKnownTypes(gv schema.GroupVersion, types ...Object) error
 
+	// AddKnownTypeWithName adds Object to Machinery with the specified schema.GroupVersionKind
+	AddKnownTypeWithName(gvk schema.GroupVersionKind, obj Object) error
+
 	// Default calls the DefaultFunc to src
 	Default(src Object) Object
 
 	// AddGlobalDefaultingFunc adds global DefaultFunc to Machinery
-	AddGlobalDefaultingFunc(fn DefaultFunc)
+	AddGlobalDefaultingFunc(fn DefaultFunc) error
 
 	// AddTypeDefaultingFunc adds DefaultFunc to Machinery
-	AddTypeDefaultingFunc(srcType Object, fn DefaultFunc)
+	AddTypeDefaultingFunc(srcType Object, fn DefaultFunc) error
+
+	// Validate validates the Object, returns the aggregated field.ErrorList
+	Validate(obj Object) field.ErrorList
+
+	// AddGlobalValidationFunc adds global ValidateFunc to Machinery
+	AddGlobalValidationFunc(fn ValidateFunc) error
+
+	// AddTypeValidationFunc adds ValidateFunc to Machinery
+	AddTypeValidationFunc(srcType Object, fn ValidateFunc) error
+
+	// AddConversionFunc adds ConversionFunc which converts src type to dst type
+	AddConversionFunc(src, dst Object, fn ConversionFunc) error
+
+	// Convert converts Object to the specified schema.GroupVersion
+	Convert(in Object, gv schema.GroupVersion) (Object, error)
+
+	// Freeze rejects all registrations after it is called
+	Freeze()
 }
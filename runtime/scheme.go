@@ -23,28 +23,51 @@
 package runtime
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/validation/field"
 )
 
 var DefaultScheme Scheme = NewScheme()
 
+// SimpleScheme is the default implementation of Scheme, it is safe for concurrent use.
 type SimpleScheme struct {
+	mu sync.RWMutex
+
 	gvkToTypes map[schema.GroupVersionKind]reflect.Type
 
-	typesToGvk map[reflect.Type]schema.GroupVersionKind
+	// typesToGvk keeps all schema.GroupVersionKind of type in registration order
+	typesToGvk map[reflect.Type][]schema.GroupVersionKind
 
 	defaultFuncs map[reflect.Type]DefaultFunc
 
 	gFn DefaultFunc
 
+	conversionFuncs map[typePair]ConversionFunc
+
+	validateFuncs map[reflect.Type][]ValidateFunc
+
+	gValidateFuncs []ValidateFunc
+
+	// itemToList pairs the item Kind with its list Kind, e.g. Foo with FooList
+	itemToList map[schema.GroupVersionKind]schema.GroupVersionKind
+
 	observedVersions []schema.GroupVersion
+
+	// frozen rejects all registrations
+	frozen bool
 }
 
 // New creates a new Object, and call global DefaultFunc
 func (s *SimpleScheme) New(gvk schema.GroupVersionKind) (Object, error) {
+	s.mu.RLock()
 	rv, exists := s.gvkToTypes[gvk]
+	gFn := s.gFn
+	s.mu.RUnlock()
 	if !exists {
 		return nil, ErrUnknownGVK
 	}
@@ -52,8 +75,8 @@ func (s *SimpleScheme) New(gvk schema.GroupVersionKind) (Object, error) {
 	out := reflect.New(rv).Interface().(Object)
 	out.GetObjectKind().SetGroupVersionKind(gvk)
 
-	if s.gFn != nil {
-		out = s.gFn(out, gvk)
+	if gFn != nil {
+		out = gFn(out, gvk)
 	}
 
 	return out, nil
@@ -61,12 +84,16 @@ func (s *SimpleScheme) New(gvk schema.GroupVersionKind) (Object, error) {
 
 // IsExists checks schema.GroupVersionKind exists
 func (s *SimpleScheme) IsExists(gvk schema.GroupVersionKind) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	_, ok := s.gvkToTypes[gvk]
 	return ok
 }
 
 // AllGVKs returns all schema.GroupVersionKind
 func (s *SimpleScheme) AllGVKs() []schema.GroupVersionKind {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	gvks := make([]schema.GroupVersionKind, 0)
 	for gvk, _ := range s.gvkToTypes {
 		gvks = append(gvks, gvk)
@@ -74,8 +101,43 @@ func (s *SimpleScheme) AllGVKs() []schema.GroupVersionKind {
 	return gvks
 }
 
+// ObjectKind returns schema.GroupVersionKind of Object. When the type is registered
+// in several versions, the one set in Object is preferred, otherwise the first registered one.
+func (s *SimpleScheme) ObjectKind(obj Object) (schema.GroupVersionKind, error) {
+	gvks, err := s.ObjectKinds(obj)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+
+	current := obj.GetObjectKind().GroupVersionKind()
+	for _, gvk := range gvks {
+		if gvk == current {
+			return gvk, nil
+		}
+	}
+	return gvks[0], nil
+}
+
+// ObjectKinds returns all schema.GroupVersionKind registered of Object
+func (s *SimpleScheme) ObjectKinds(obj Object) ([]schema.GroupVersionKind, error) {
+	rt := reflect.TypeOf(obj)
+	if rt.Kind() != reflect.Ptr {
+		return nil, ErrIsNotPointer
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	gvks, exists := s.typesToGvk[rt.Elem()]
+	if !exists {
+		return nil, ErrUnknownType
+	}
+	return append([]schema.GroupVersionKind{}, gvks...), nil
+}
+
 // AllObjects returns all Object
 func (s *SimpleScheme) AllObjects() []Object {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	objects := make([]Object, 0)
 	for gvk, rv := range s.gvkToTypes {
 		out := reflect.New(rv).Interface().(Object)
@@ -85,9 +147,17 @@ func (s *SimpleScheme) AllObjects() []Object {
 	return objects
 }
 
-// AddKnownTypes add Object to Scheme
+// AddKnownTypes add Object to Scheme, the Kind is the name of type. All types are checked before
+// any of them is registered, nothing is registered when one of them is rejected.
 func (s *SimpleScheme) AddKnownTypes(gv schema.GroupVersion, types ...Object) error {
-	s.addObservedVersion(gv)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+
+	known := make(map[schema.GroupVersionKind]reflect.Type, len(types))
 	for _, v := range types {
 		rt := reflect.TypeOf(v)
 		if rt.Kind() != reflect.Ptr {
@@ -95,42 +165,208 @@ func (s *SimpleScheme) AddKnownTypes(gv schema.GroupVersion, types ...Object) er
 		}
 		rt = rt.Elem()
 		gvk := gv.WithKind(rt.Name())
-		s.gvkToTypes[gvk] = rt
-		s.typesToGvk[rt] = gvk
+		if existing, exists := known[gvk]; exists && existing != rt {
+			return &RegistrationConflictError{GVK: gvk, Existing: existing, New: rt}
+		}
+		if err := s.checkKnownType(gvk, rt); err != nil {
+			return err
+		}
+		known[gvk] = rt
+	}
+
+	s.addObservedVersion(gv)
+	for _, v := range types {
+		rt := reflect.TypeOf(v).Elem()
+		s.addKnownType(gv.WithKind(rt.Name()), rt)
 	}
 
 	return nil
 }
 
-// Default call global DefaultFunc specifies DefaultFunc
-func (s *SimpleScheme) Default(src Object) Object {
-	rt := reflect.TypeOf(src)
-	if rt.Kind() == reflect.Ptr {
-		rt = rt.Elem()
+// AddKnownTypeWithName add Object to Scheme with the specified schema.GroupVersionKind
+func (s *SimpleScheme) AddKnownTypeWithName(gvk schema.GroupVersionKind, obj Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+
+	rt := reflect.TypeOf(obj)
+	if rt.Kind() != reflect.Ptr {
+		return ErrIsNotPointer
+	}
+	rt = rt.Elem()
+	if err := s.checkKnownType(gvk, rt); err != nil {
+		return err
+	}
+
+	s.addObservedVersion(gvk.GroupVersion())
+	s.addKnownType(gvk, rt)
+	return nil
+}
+
+// checkKnownType checks whether the type can be registered as gvk, and parses its default tags
+func (s *SimpleScheme) checkKnownType(gvk schema.GroupVersionKind, rt reflect.Type) error {
+	if existing, exists := s.gvkToTypes[gvk]; exists && existing != rt {
+		return &RegistrationConflictError{GVK: gvk, Existing: existing, New: rt}
+	}
+	return checkTagDefaults(rt)
+}
+
+// addKnownType registers the type which has been checked by checkKnownType
+func (s *SimpleScheme) addKnownType(gvk schema.GroupVersionKind, rt reflect.Type) {
+	if _, exists := s.gvkToTypes[gvk]; exists {
+		return
+	}
+
+	s.gvkToTypes[gvk] = rt
+	s.typesToGvk[rt] = append(s.typesToGvk[rt], gvk)
+
+	if isListType(gvk.Kind, rt) {
+		item := gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, listSuffix))
+		s.itemToList[item] = gvk
+	}
+}
+
+// NewList creates a new list Object of the given item schema.GroupVersionKind, e.g. FooList of Foo
+func (s *SimpleScheme) NewList(gvk schema.GroupVersionKind) (Object, error) {
+	s.mu.RLock()
+	listGVK, exists := s.itemToList[gvk]
+	s.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: no list kind of %s", ErrUnknownGVK, gvk)
+	}
+
+	return s.New(listGVK)
+}
+
+// ListKind returns the list schema.GroupVersionKind of the given item schema.GroupVersionKind
+func (s *SimpleScheme) ListKind(gvk schema.GroupVersionKind) (schema.GroupVersionKind, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	listGVK, exists := s.itemToList[gvk]
+	return listGVK, exists
+}
+
+const listSuffix = "List"
+
+// isListType checks whether the type is named FooList and has Items field of slice
+func isListType(kind string, rt reflect.Type) bool {
+	if !strings.HasSuffix(kind, listSuffix) || len(kind) == len(listSuffix) || rt.Kind() != reflect.Struct {
+		return false
 	}
 
-	gvk := s.typesToGvk[rt]
+	items, ok := rt.FieldByName("Items")
+	return ok && items.Type.Kind() == reflect.Slice
+}
+
+// Default sets the values declared by the default tags of src, then calls global DefaultFunc
+// and the DefaultFunc of the type
+func (s *SimpleScheme) Default(src Object) Object {
+	rt := typeKey(src)
+
+	gvk, _ := s.ObjectKind(src)
 	if src.GetObjectKind().GroupVersionKind().Empty() {
 		src.GetObjectKind().SetGroupVersionKind(gvk)
 	}
 
-	if s.gFn != nil {
-		src = s.gFn(src, gvk)
-	}
+	applyTagDefaults(reflect.ValueOf(src))
 
+	s.mu.RLock()
+	gFn := s.gFn
 	fn, exists := s.defaultFuncs[rt]
+	s.mu.RUnlock()
+
+	if gFn != nil {
+		src = gFn(src, gvk)
+	}
+
 	if exists {
 		src = fn(src, gvk)
 	}
 	return src
 }
 
-func (s *SimpleScheme) AddTypeDefaultingFunc(srcType Object, fn DefaultFunc) {
-	s.defaultFuncs[reflect.TypeOf(srcType)] = fn
+// AddTypeDefaultingFunc adds DefaultFunc of the type, returns ErrSchemeFrozen when the Scheme is frozen
+func (s *SimpleScheme) AddTypeDefaultingFunc(srcType Object, fn DefaultFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+	s.defaultFuncs[typeKey(srcType)] = fn
+	return nil
+}
+
+// typeKey returns the key of the type in the registries of DefaultFunc and ValidateFunc,
+// the pointer and the value of a type share the same key.
+func typeKey(obj Object) reflect.Type {
+	rt := reflect.TypeOf(obj)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt
 }
 
-func (s *SimpleScheme) AddGlobalDefaultingFunc(fn DefaultFunc) {
+// AddGlobalDefaultingFunc adds global DefaultFunc, returns ErrSchemeFrozen when the Scheme is frozen
+func (s *SimpleScheme) AddGlobalDefaultingFunc(fn DefaultFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
 	s.gFn = fn
+	return nil
+}
+
+// PrioritizedVersionsAllGroups returns all registered schema.GroupVersion in priority order. Groups are
+// ordered by registration, the versions of a group are ordered by schema.CompareVersionPriority.
+func (s *SimpleScheme) PrioritizedVersionsAllGroups() []schema.GroupVersion {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	gvs := append([]schema.GroupVersion{}, s.observedVersions...)
+	schema.SortGroupVersions(gvs)
+	return gvs
+}
+
+// PrioritizedVersionsForGroup returns the registered schema.GroupVersion of group in priority order
+func (s *SimpleScheme) PrioritizedVersionsForGroup(group string) []schema.GroupVersion {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	gvs := make([]schema.GroupVersion, 0)
+	for _, gv := range s.observedVersions {
+		if gv.Group == group {
+			gvs = append(gvs, gv)
+		}
+	}
+	schema.SortGroupVersions(gvs)
+	return gvs
+}
+
+// PreferredVersion returns the schema.GroupVersion of group with the highest priority,
+// returns false if the group is not registered.
+func (s *SimpleScheme) PreferredVersion(group string) (schema.GroupVersion, bool) {
+	gvs := s.PrioritizedVersionsForGroup(group)
+	if len(gvs) == 0 {
+		return schema.GroupVersion{}, false
+	}
+	return gvs[0], true
+}
+
+// Freeze rejects all registrations after it is called, a frozen Scheme can be read
+// concurrently without any registration racing.
+func (s *SimpleScheme) Freeze() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.frozen = true
+}
+
+// IsFrozen checks whether the Scheme is frozen
+func (s *SimpleScheme) IsFrozen() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.frozen
 }
 
 func (s *SimpleScheme) addObservedVersion(gv schema.GroupVersion) {
@@ -150,8 +386,11 @@ func (s *SimpleScheme) addObservedVersion(gv schema.GroupVersion) {
 func NewScheme() *SimpleScheme {
 	return &SimpleScheme{
 		gvkToTypes:       map[schema.GroupVersionKind]reflect.Type{},
-		typesToGvk:       map[reflect.Type]schema.GroupVersionKind{},
+		typesToGvk:       map[reflect.Type][]schema.GroupVersionKind{},
 		defaultFuncs:     map[reflect.Type]DefaultFunc{},
+		conversionFuncs:  map[typePair]ConversionFunc{},
+		validateFuncs:    map[reflect.Type][]ValidateFunc{},
+		itemToList:       map[schema.GroupVersionKind]schema.GroupVersionKind{},
 		observedVersions: []schema.GroupVersion{},
 	}
 }
@@ -161,6 +400,26 @@ func NewObject(gvk schema.GroupVersionKind) (Object, error) {
 	return DefaultScheme.New(gvk)
 }
 
+// NewList calls DefaultScheme.NewList()
+func NewList(gvk schema.GroupVersionKind) (Object, error) {
+	return DefaultScheme.NewList(gvk)
+}
+
+// PrioritizedVersionsAllGroups calls DefaultScheme.PrioritizedVersionsAllGroups()
+func PrioritizedVersionsAllGroups() []schema.GroupVersion {
+	return DefaultScheme.PrioritizedVersionsAllGroups()
+}
+
+// PrioritizedVersionsForGroup calls DefaultScheme.PrioritizedVersionsForGroup()
+func PrioritizedVersionsForGroup(group string) []schema.GroupVersion {
+	return DefaultScheme.PrioritizedVersionsForGroup(group)
+}
+
+// PreferredVersion calls DefaultScheme.PreferredVersion()
+func PreferredVersion(group string) (schema.GroupVersion, bool) {
+	return DefaultScheme.PreferredVersion(group)
+}
+
 // IsExists calls DefaultScheme.IsExists()
 func IsExists(gvk schema.GroupVersionKind) bool {
 	return DefaultScheme.IsExists(gvk)
@@ -171,6 +430,16 @@ func AllGVKs() []schema.GroupVersionKind {
 	return DefaultScheme.AllGVKs()
 }
 
+// ObjectKind calls DefaultScheme.ObjectKind()
+func ObjectKind(obj Object) (schema.GroupVersionKind, error) {
+	return DefaultScheme.ObjectKind(obj)
+}
+
+// ObjectKinds calls DefaultScheme.ObjectKinds()
+func ObjectKinds(obj Object) ([]schema.GroupVersionKind, error) {
+	return DefaultScheme.ObjectKinds(obj)
+}
+
 // AllObjects calls DefaultScheme.AllObjects()
 func AllObjects() []Object {
 	return DefaultScheme.AllObjects()
@@ -181,17 +450,47 @@ func AddKnownTypes(gv schema.GroupVersion, types ...Object) error {
 	return DefaultScheme.AddKnownTypes(gv, types...)
 }
 
+// AddKnownTypeWithName calls DefaultScheme.AddKnownTypeWithName()
+func AddKnownTypeWithName(gvk schema.GroupVersionKind, obj Object) error {
+	return DefaultScheme.AddKnownTypeWithName(gvk, obj)
+}
+
 // DefaultObject calls DefaultScheme.Default()
 func DefaultObject(src Object) Object {
 	return DefaultScheme.Default(src)
 }
 
 // AddGlobalDefaultingFunc calls DefaultScheme.AddTypeDefaultingFunc()
-func AddGlobalDefaultingFunc(fn DefaultFunc) {
-	DefaultScheme.AddGlobalDefaultingFunc(fn)
+func AddGlobalDefaultingFunc(fn DefaultFunc) error {
+	return DefaultScheme.AddGlobalDefaultingFunc(fn)
 }
 
 // AddTypeDefaultingFunc calls DefaultScheme.AddTypeDefaultingFunc()
-func AddTypeDefaultingFunc(srcType Object, fn DefaultFunc) {
-	DefaultScheme.AddTypeDefaultingFunc(srcType, fn)
+func AddTypeDefaultingFunc(srcType Object, fn DefaultFunc) error {
+	return DefaultScheme.AddTypeDefaultingFunc(srcType, fn)
+}
+
+// AddConversionFunc calls DefaultScheme.AddConversionFunc()
+func AddConversionFunc(src, dst Object, fn ConversionFunc) error {
+	return DefaultScheme.AddConversionFunc(src, dst, fn)
+}
+
+// ConvertToVersion calls DefaultScheme.Convert()
+func ConvertToVersion(in Object, gv schema.GroupVersion) (Object, error) {
+	return DefaultScheme.Convert(in, gv)
+}
+
+// ValidateObject calls DefaultScheme.Validate()
+func ValidateObject(obj Object) field.ErrorList {
+	return DefaultScheme.Validate(obj)
+}
+
+// AddGlobalValidationFunc calls DefaultScheme.AddGlobalValidationFunc()
+func AddGlobalValidationFunc(fn ValidateFunc) error {
+	return DefaultScheme.AddGlobalValidationFunc(fn)
+}
+
+// AddTypeValidationFunc calls DefaultScheme.AddTypeValidationFunc()
+func AddTypeValidationFunc(srcType Object, fn ValidateFunc) error {
+	return DefaultScheme.AddTypeValidationFunc(srcType, fn)
 }
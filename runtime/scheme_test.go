@@ -1,8 +1,10 @@
 package runtime
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 
 	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
@@ -88,3 +90,141 @@ func TestDefaultObject(t *testing.T) {
 		})
 	}
 }
+
+type TestOther struct {
+	metav1.TypeMeta
+}
+
+func (t *TestOther) DeepCopyObject() Object {
+	out := new(TestOther)
+	*out = *t
+	return out
+}
+
+func (t *TestOther) DeepFromObject(out Object) {
+	out = t.DeepCopyObject()
+}
+
+func TestSimpleScheme_AddKnownTypes(t *testing.T) {
+	v1 := schema.GroupVersion{Group: "test", Version: "v1"}
+	v2 := schema.GroupVersion{Group: "test", Version: "v2"}
+
+	scheme := NewScheme()
+	if err := scheme.AddKnownTypes(v1, &TestObj{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := scheme.AddKnownTypes(v1, &TestObj{}); err != nil {
+		t.Fatalf("registers the same type again: %v", err)
+	}
+	if err := scheme.AddKnownTypes(v2, &TestObj{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := scheme.AddKnownTypeWithName(v1.WithKind("TestObj"), &TestOther{})
+	if !IsRegistrationConflict(err) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	gvks, err := scheme.ObjectKinds(&TestObj{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []schema.GroupVersionKind{v1.WithKind("TestObj"), v2.WithKind("TestObj")}
+	if !reflect.DeepEqual(gvks, want) {
+		t.Fatalf("ObjectKinds() = %v, want %v", gvks, want)
+	}
+
+	obj := &TestObj{}
+	obj.SetGroupVersionKind(v2.WithKind("TestObj"))
+	if gvk, _ := scheme.ObjectKind(obj); gvk != v2.WithKind("TestObj") {
+		t.Fatalf("ObjectKind() = %v, want v2", gvk)
+	}
+
+	// nothing of the batch is registered when one type is rejected
+	v3 := schema.GroupVersion{Group: "test", Version: "v3"}
+	if err = scheme.AddKnownTypes(v3, &TestOther{}, valueObj{}); !errors.Is(err, ErrIsNotPointer) {
+		t.Fatalf("expected ErrIsNotPointer, got %v", err)
+	}
+	if scheme.IsExists(v3.WithKind("TestOther")) {
+		t.Fatal("TestOther registered by a rejected batch")
+	}
+	if gvs := scheme.PrioritizedVersionsForGroup("test"); len(gvs) != 2 {
+		t.Fatalf("version of a rejected batch observed: %v", gvs)
+	}
+
+	scheme.Freeze()
+	if err = scheme.AddKnownTypes(v1, &TestOther{}); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected frozen error, got %v", err)
+	}
+	if err = scheme.AddTypeDefaultingFunc(&TestObj{}, nil); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected frozen error, got %v", err)
+	}
+	if err = scheme.AddGlobalDefaultingFunc(nil); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected frozen error, got %v", err)
+	}
+	if err = scheme.AddTypeValidationFunc(&TestObj{}, nil); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected frozen error, got %v", err)
+	}
+	if err = scheme.AddGlobalValidationFunc(nil); !errors.Is(err, ErrSchemeFrozen) {
+		t.Fatalf("expected frozen error, got %v", err)
+	}
+}
+
+func TestSimpleScheme_PreferredVersion(t *testing.T) {
+	scheme := NewScheme()
+	for _, gv := range []schema.GroupVersion{
+		{Group: "test", Version: "v1beta1"},
+		{Group: "other", Version: "v1"},
+		{Group: "test", Version: "v1"},
+		{Group: "test", Version: "v1alpha1"},
+	} {
+		if err := scheme.AddKnownTypes(gv, &TestObj{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []schema.GroupVersion{
+		{Group: "test", Version: "v1"},
+		{Group: "test", Version: "v1beta1"},
+		{Group: "test", Version: "v1alpha1"},
+	}
+	if got := scheme.PrioritizedVersionsForGroup("test"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("PrioritizedVersionsForGroup() = %v, want %v", got, want)
+	}
+
+	want = append(want, schema.GroupVersion{Group: "other", Version: "v1"})
+	if got := scheme.PrioritizedVersionsAllGroups(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("PrioritizedVersionsAllGroups() = %v, want %v", got, want)
+	}
+
+	if gv, ok := scheme.PreferredVersion("test"); !ok || gv.Version != "v1" {
+		t.Fatalf("PreferredVersion() = %v, %v", gv, ok)
+	}
+	if _, ok := scheme.PreferredVersion("unknown"); ok {
+		t.Fatal("PreferredVersion() of unknown group")
+	}
+}
+
+func TestSimpleScheme_Concurrent(t *testing.T) {
+	scheme := NewScheme()
+	gvk := gv.WithKind("TestObj")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = scheme.AddKnownTypes(gv, &TestObj{})
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = scheme.New(gvk)
+			_ = scheme.AllGVKs()
+		}()
+	}
+	wg.Wait()
+
+	if !scheme.IsExists(gvk) {
+		t.Fatalf("%v not registered", gvk)
+	}
+}
@@ -0,0 +1,71 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serializer
+
+import (
+	"mime"
+
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+// CodecFactory provides methods for retrieving runtime.Codec for the supported media types
+type CodecFactory struct {
+	serializers []runtime.SerializerInfo
+}
+
+// NewCodecFactory creates CodecFactory which supports JSON, YAML and protobuf
+func NewCodecFactory(scheme runtime.Scheme) CodecFactory {
+	return CodecFactory{
+		serializers: []runtime.SerializerInfo{
+			{MediaType: ContentTypeJSON, Codec: NewJSONCodec(scheme)},
+			{MediaType: ContentTypeYAML, Codec: NewYAMLCodec(scheme)},
+			{MediaType: ContentTypeProtobuf, Codec: NewProtobufCodec(scheme)},
+		},
+	}
+}
+
+// SupportedMediaTypes returns the RFC2046 media types that this factory has serializers for.
+func (f CodecFactory) SupportedMediaTypes() []runtime.SerializerInfo {
+	return f.serializers
+}
+
+// CodecForMediaType returns runtime.Codec of the media type, the parameters
+// of media type (e.g. charset) are ignored.
+func (f CodecFactory) CodecForMediaType(mediaType string) (runtime.Codec, bool) {
+	if mt, _, err := mime.ParseMediaType(mediaType); err == nil {
+		mediaType = mt
+	}
+
+	for _, info := range f.serializers {
+		if info.MediaType == mediaType {
+			return info.Codec, true
+		}
+	}
+	return nil, false
+}
+
+// LegacyCodec returns runtime.Codec for JSON
+func (f CodecFactory) LegacyCodec() runtime.Codec {
+	codec, _ := f.CodecForMediaType(ContentTypeJSON)
+	return codec
+}
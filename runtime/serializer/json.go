@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serializer
+
+import (
+	"fmt"
+	"io"
+
+	json "github.com/json-iterator/go"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeYAML     = "application/yaml"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+var (
+	ErrMissingKind     = fmt.Errorf("object has no kind")
+	ErrNotProtoMessage = fmt.Errorf("object does not implement the protobuf marshalling interface")
+	ErrInvalidEnvelope = fmt.Errorf("invalid protobuf envelope")
+)
+
+// typeMeta is used to probe apiVersion and kind from the serialized data
+type typeMeta struct {
+	ApiVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+func (t typeMeta) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromGVK(t.ApiVersion + "." + t.Kind)
+}
+
+var _ runtime.Codec = (*jsonCodec)(nil)
+
+type jsonCodec struct {
+	scheme runtime.Scheme
+}
+
+// NewJSONCodec creates a runtime.Codec for JSON
+func NewJSONCodec(scheme runtime.Scheme) runtime.Codec {
+	return &jsonCodec{scheme: scheme}
+}
+
+func (c *jsonCodec) Encode(obj runtime.Object, w io.Writer) error {
+	obj, err := withKind(c.scheme, obj)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (c *jsonCodec) Decode(data []byte, into runtime.Object) (runtime.Object, error) {
+	var gvk schema.GroupVersionKind
+	if into == nil {
+		tm := typeMeta{}
+		if err := json.Unmarshal(data, &tm); err != nil {
+			return nil, err
+		}
+
+		var err error
+		gvk = resolveKind(c.scheme, tm.GroupVersionKind())
+		into, err = newObject(c.scheme, gvk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(data, into); err != nil {
+		return nil, err
+	}
+	if gvk.Kind != "" {
+		into.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	return into, nil
+}
+
+// withKind returns the Object with TypeMeta filled. The Object is returned as is when its TypeMeta
+// is present, otherwise the TypeMeta is set on a copy, the given Object is left unchanged.
+func withKind(scheme runtime.Scheme, obj runtime.Object) (runtime.Object, error) {
+	if obj.GetObjectKind().GroupVersionKind().Kind != "" {
+		return obj, nil
+	}
+
+	gvk, err := scheme.ObjectKind(obj)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %T", err, obj)
+	}
+	out := obj.DeepCopyObject()
+	out.GetObjectKind().SetGroupVersionKind(gvk)
+	return out, nil
+}
+
+// resolveKind fills the version of schema.GroupVersionKind when it is omitted, the registered
+// version of group with the highest priority is chosen.
+func resolveKind(scheme runtime.Scheme, gvk schema.GroupVersionKind) schema.GroupVersionKind {
+	if gvk.Version != "" || gvk.Kind == "" {
+		return gvk
+	}
+
+	for _, gv := range scheme.PrioritizedVersionsForGroup(gvk.Group) {
+		if target := gv.WithKind(gvk.Kind); scheme.IsExists(target) {
+			return target
+		}
+	}
+	return gvk
+}
+
+func newObject(scheme runtime.Scheme, gvk schema.GroupVersionKind) (runtime.Object, error) {
+	if gvk.Kind == "" {
+		return nil, ErrMissingKind
+	}
+
+	out, err := scheme.New(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, gvk)
+	}
+	return out, nil
+}
@@ -0,0 +1,134 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serializer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+// protoEnvelopePrefix is the magic prefix of the protobuf envelope
+var protoEnvelopePrefix = []byte("vine\x00")
+
+// protoMessage is implemented by the types generated by gogo protobuf
+type protoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+var _ runtime.Codec = (*protobufCodec)(nil)
+
+// protobufCodec writes objects in an envelope:
+//
+//	magic prefix | varint length of metav1.TypeMeta | metav1.TypeMeta | object
+//
+// since the generated message of object does not always contain its TypeMeta.
+type protobufCodec struct {
+	scheme runtime.Scheme
+}
+
+// NewProtobufCodec creates a runtime.Codec for protobuf
+func NewProtobufCodec(scheme runtime.Scheme) runtime.Codec {
+	return &protobufCodec{scheme: scheme}
+}
+
+func (c *protobufCodec) Encode(obj runtime.Object, w io.Writer) error {
+	if _, ok := obj.(protoMessage); !ok {
+		return fmt.Errorf("%w: %T", ErrNotProtoMessage, obj)
+	}
+
+	obj, err := withKind(c.scheme, obj)
+	if err != nil {
+		return err
+	}
+	pm := obj.(protoMessage)
+
+	tm := &metav1.TypeMeta{}
+	tm.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	head, err := tm.Marshal()
+	if err != nil {
+		return err
+	}
+	body, err := pm.Marshal()
+	if err != nil {
+		return err
+	}
+
+	size := make([]byte, binary.MaxVarintLen64)
+	size = size[:binary.PutUvarint(size, uint64(len(head)))]
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(protoEnvelopePrefix)+len(size)+len(head)+len(body)))
+	buf.Write(protoEnvelopePrefix)
+	buf.Write(size)
+	buf.Write(head)
+	buf.Write(body)
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+func (c *protobufCodec) Decode(data []byte, into runtime.Object) (runtime.Object, error) {
+	if !bytes.HasPrefix(data, protoEnvelopePrefix) {
+		return nil, ErrInvalidEnvelope
+	}
+	data = data[len(protoEnvelopePrefix):]
+
+	n, size := binary.Uvarint(data)
+	if size <= 0 || uint64(len(data)-size) < n {
+		return nil, ErrInvalidEnvelope
+	}
+	data = data[size:]
+
+	tm := &metav1.TypeMeta{}
+	if err := tm.Unmarshal(data[:n]); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	data = data[n:]
+
+	gvk := resolveKind(c.scheme, tm.GroupVersionKind())
+	if into == nil {
+		var err error
+		into, err = newObject(c.scheme, gvk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pm, ok := into.(protoMessage)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotProtoMessage, into)
+	}
+	if err := pm.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	if gvk.Kind != "" {
+		into.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	return into, nil
+}
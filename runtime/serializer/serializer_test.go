@@ -0,0 +1,150 @@
+package serializer
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+var gv = schema.GroupVersion{Group: "test", Version: "v1"}
+
+type TestObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+}
+
+func (t *TestObj) DeepCopyObject() runtime.Object {
+	out := new(TestObj)
+	*out = *t
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return out
+}
+
+func (t *TestObj) DeepFromObject(o runtime.Object) {
+	*t = *o.DeepCopyObject().(*TestObj)
+}
+
+func (t *TestObj) Marshal() ([]byte, error) {
+	return t.ObjectMeta.Marshal()
+}
+
+func (t *TestObj) Unmarshal(data []byte) error {
+	return t.ObjectMeta.Unmarshal(data)
+}
+
+func newScheme(t *testing.T) runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestObj{}); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func TestCodecFactory(t *testing.T) {
+	factory := NewCodecFactory(newScheme(t))
+
+	tests := []struct {
+		name      string
+		mediaType string
+	}{
+		{name: "json", mediaType: "application/json; charset=utf-8"},
+		{name: "yaml", mediaType: ContentTypeYAML},
+		{name: "protobuf", mediaType: ContentTypeProtobuf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, ok := factory.CodecForMediaType(tt.mediaType)
+			if !ok {
+				t.Fatalf("missing codec for %s", tt.mediaType)
+			}
+
+			in := &TestObj{ObjectMeta: metav1.ObjectMeta{Name: "o1", Labels: map[string]string{"a": "b"}}}
+			buf := bytes.NewBuffer([]byte{})
+			if err := codec.Encode(in, buf); err != nil {
+				t.Fatal(err)
+			}
+			// the TypeMeta is written without changing the given object
+			if in.GroupVersionKind() != (schema.GroupVersionKind{}) {
+				t.Fatalf("TypeMeta of object is changed: %v", in.TypeMeta)
+			}
+
+			out, err := codec.Decode(buf.Bytes(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			in.SetGroupVersionKind(gv.WithKind("TestObj"))
+			if !reflect.DeepEqual(in, out) {
+				t.Fatalf("got %#v, want %#v", out, in)
+			}
+		})
+	}
+}
+
+func TestYAMLCodec_DecodeAll(t *testing.T) {
+	codec := NewYAMLCodec(newScheme(t))
+
+	data := `apiVersion: test/v1
+kind: TestObj
+metadata:
+  name: o1
+---
+---
+apiVersion: test/v1
+kind: TestObj
+metadata:
+  name: o2
+  labels:
+    version: "1"
+`
+	objects, err := codec.DecodeAll([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("got %d objects", len(objects))
+	}
+	o2 := objects[1].(*TestObj)
+	if o2.Name != "o2" || o2.Labels["version"] != "1" {
+		t.Fatalf("unexpected object: %#v", o2)
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err = codec.EncodeAll(buf, objects...); err != nil {
+		t.Fatal(err)
+	}
+	again, err := codec.DecodeAll(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(objects, again) {
+		t.Fatalf("got %v, want %v", again, objects)
+	}
+
+	if _, err = codec.Decode([]byte("metadata:\n  name: o3\n"), nil); err == nil {
+		t.Fatal("expected missing kind error")
+	}
+}
+
+func TestJSONCodec_DecodeWithoutVersion(t *testing.T) {
+	scheme := newScheme(t)
+	v2 := schema.GroupVersion{Group: gv.Group, Version: "v2beta1"}
+	if err := scheme.AddKnownTypes(v2, &TestObj{}); err != nil {
+		t.Fatal(err)
+	}
+
+	codec := NewJSONCodec(scheme)
+	out, err := codec.Decode([]byte(`{"apiVersion":"test/","kind":"TestObj","metadata":{"name":"o1"}}`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gvk := out.GetObjectKind().GroupVersionKind(); gvk != gv.WithKind("TestObj") {
+		t.Errorf("decoded gvk = %v, want preferred %v", gvk, gv.WithKind("TestObj"))
+	}
+	if out.(*TestObj).Name != "o1" {
+		t.Errorf("decoded object = %v", out)
+	}
+}
@@ -0,0 +1,172 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serializer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	json "github.com/json-iterator/go"
+	"github.com/vine-io/apimachinery/runtime"
+	"gopkg.in/yaml.v3"
+)
+
+const yamlSeparator = "---\n"
+
+var _ runtime.StreamCodec = (*yamlCodec)(nil)
+
+// yamlCodec converts YAML to JSON and reuses jsonCodec, so that the json tags of
+// objects are honoured.
+type yamlCodec struct {
+	json *jsonCodec
+}
+
+// NewYAMLCodec creates a runtime.StreamCodec for YAML
+func NewYAMLCodec(scheme runtime.Scheme) runtime.StreamCodec {
+	return &yamlCodec{json: &jsonCodec{scheme: scheme}}
+}
+
+func (c *yamlCodec) Encode(obj runtime.Object, w io.Writer) error {
+	buf := bytes.NewBuffer([]byte{})
+	if err := c.json.Encode(obj, buf); err != nil {
+		return err
+	}
+
+	data, err := jsonToYAML(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (c *yamlCodec) EncodeAll(w io.Writer, objects ...runtime.Object) error {
+	for i, obj := range objects {
+		if i > 0 {
+			if _, err := io.WriteString(w, yamlSeparator); err != nil {
+				return err
+			}
+		}
+		if err := c.Encode(obj, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *yamlCodec) Decode(data []byte, into runtime.Object) (runtime.Object, error) {
+	var value any
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(toJSONValue(value))
+	if err != nil {
+		return nil, err
+	}
+	return c.json.Decode(b, into)
+}
+
+func (c *yamlCodec) DecodeAll(data []byte) ([]runtime.Object, error) {
+	objects := make([]runtime.Object, 0)
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// skips empty document
+		if value == nil {
+			continue
+		}
+
+		b, err := json.Marshal(toJSONValue(value))
+		if err != nil {
+			return nil, err
+		}
+		obj, err := c.json.Decode(b, nil)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", len(objects), err)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// toJSONValue converts the map[any]any decoded by yaml to map[string]any
+func toJSONValue(value any) any {
+	switch vv := value.(type) {
+	case map[string]any:
+		for k, v := range vv {
+			vv[k] = toJSONValue(v)
+		}
+		return vv
+	case map[any]any:
+		out := make(map[string]any, len(vv))
+		for k, v := range vv {
+			out[fmt.Sprint(k)] = toJSONValue(v)
+		}
+		return out
+	case []any:
+		for i := range vv {
+			vv[i] = toJSONValue(vv[i])
+		}
+		return vv
+	default:
+		return vv
+	}
+}
+
+// jsonToYAML converts JSON to block style YAML and keeps the order of fields
+func jsonToYAML(data []byte) ([]byte, error) {
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+	resetStyle(node)
+
+	buf := bytes.NewBuffer([]byte{})
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, item := range node.Content {
+		resetStyle(item)
+	}
+}
@@ -0,0 +1,645 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"fmt"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+var (
+	_ Object            = (*Unstructured)(nil)
+	_ schema.ObjectKind = (*Unstructured)(nil)
+	_ metav1.Meta       = (*Unstructured)(nil)
+)
+
+// Unstructured allows objects that do not have Golang structs registered to be manipulated
+// generically. The metadata of object is stored under the "metadata" field.
+type Unstructured struct {
+	// Object is a JSON compatible map with string, float, int, bool, []any, or
+	// map[string]any children.
+	Object map[string]any
+}
+
+func (u *Unstructured) GetObjectKind() schema.ObjectKind {
+	return u
+}
+
+func (u *Unstructured) SetGroupVersionKind(gvk schema.GroupVersionKind) {
+	u.setNestedField(gvk.APIGroup(), "apiVersion")
+	u.setNestedField(gvk.Kind, "kind")
+}
+
+func (u *Unstructured) GroupVersionKind() schema.GroupVersionKind {
+	gv, err := schema.ParseGroupVersion(u.GetAPIVersion())
+	if err != nil {
+		return schema.GroupVersionKind{}
+	}
+	return gv.WithKind(u.GetKind())
+}
+
+func (u *Unstructured) GetAPIVersion() string {
+	return getNestedString(u.Object, "apiVersion")
+}
+
+func (u *Unstructured) SetAPIVersion(version string) {
+	u.setNestedField(version, "apiVersion")
+}
+
+func (u *Unstructured) GetKind() string {
+	return getNestedString(u.Object, "kind")
+}
+
+func (u *Unstructured) SetKind(kind string) {
+	u.setNestedField(kind, "kind")
+}
+
+func (u *Unstructured) DeepCopyObject() Object {
+	return u.DeepCopy()
+}
+
+func (u *Unstructured) DeepFromObject(o Object) {
+	if in, ok := o.(*Unstructured); ok {
+		*u = *in.DeepCopy()
+	}
+}
+
+// DeepCopy creates a new Unstructured
+func (u *Unstructured) DeepCopy() *Unstructured {
+	if u == nil {
+		return nil
+	}
+	out := new(Unstructured)
+	if u.Object != nil {
+		out.Object = DeepCopyJSONValue(u.Object).(map[string]any)
+	}
+	return out
+}
+
+func (u *Unstructured) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.Object)
+}
+
+func (u *Unstructured) UnmarshalJSON(data []byte) error {
+	m, err := unmarshalMap(data)
+	if err != nil {
+		return err
+	}
+	u.Object = m
+	return nil
+}
+
+func (u *Unstructured) GetName() string {
+	return getNestedString(u.Object, "metadata", "name")
+}
+
+func (u *Unstructured) SetName(name string) {
+	u.setNestedField(name, "metadata", "name")
+}
+
+func (u *Unstructured) GetUID() any {
+	v, _ := NestedField(u.Object, "metadata", "uid")
+	if n, ok := toInt64(v); ok {
+		return n
+	}
+	return v
+}
+
+func (u *Unstructured) SetUID(uid any) {
+	u.setNestedField(uid, "metadata", "uid")
+}
+
+func (u *Unstructured) GetResourceVersion() string {
+	return getNestedString(u.Object, "metadata", "resourceVersion")
+}
+
+func (u *Unstructured) SetResourceVersion(rv string) {
+	u.setNestedField(rv, "metadata", "resourceVersion")
+}
+
+func (u *Unstructured) GetNamespace() string {
+	return getNestedString(u.Object, "metadata", "namespace")
+}
+
+func (u *Unstructured) SetNamespace(ns string) {
+	u.setNestedField(ns, "metadata", "namespace")
+}
+
+func (u *Unstructured) GetDescription() string {
+	return getNestedString(u.Object, "metadata", "description")
+}
+
+func (u *Unstructured) SetDescription(desc string) {
+	u.setNestedField(desc, "metadata", "description")
+}
+
+func (u *Unstructured) GetCreationTimestamp() int64 {
+	return getNestedInt64(u.Object, "metadata", "creationTimestamp")
+}
+
+func (u *Unstructured) SetCreationTimestamp(t int64) {
+	u.setNestedField(t, "metadata", "creationTimestamp")
+}
+
+func (u *Unstructured) GetUpdateTimestamp() int64 {
+	return getNestedInt64(u.Object, "metadata", "updateTimestamp")
+}
+
+func (u *Unstructured) SetUpdateTimestamp(t int64) {
+	u.setNestedField(t, "metadata", "updateTimestamp")
+}
+
+func (u *Unstructured) GetDeletionTimestamp() int64 {
+	return getNestedInt64(u.Object, "metadata", "deletionTimestamp")
+}
+
+func (u *Unstructured) SetDeletionTimestamp(t int64) {
+	u.setNestedField(t, "metadata", "deletionTimestamp")
+}
+
+func (u *Unstructured) GetLabels() map[string]string {
+	return getNestedStringMap(u.Object, "metadata", "labels")
+}
+
+func (u *Unstructured) SetLabels(labels map[string]string) {
+	u.setNestedStringMap(labels, "metadata", "labels")
+}
+
+func (u *Unstructured) GetAnnotations() map[string]string {
+	return getNestedStringMap(u.Object, "metadata", "annotations")
+}
+
+func (u *Unstructured) SetAnnotations(annotations map[string]string) {
+	u.setNestedStringMap(annotations, "metadata", "annotations")
+}
+
+func (u *Unstructured) GetGenerateName() string {
+	return getNestedString(u.Object, "metadata", "generateName")
+}
+
+func (u *Unstructured) SetGenerateName(cn string) {
+	u.setNestedField(cn, "metadata", "generateName")
+}
+
+func (u *Unstructured) GetReferences() []*metav1.OwnerReference {
+	v, found := NestedField(u.Object, "metadata", "references")
+	if !found || v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	references := make([]*metav1.OwnerReference, 0)
+	if err = json.Unmarshal(data, &references); err != nil {
+		return nil
+	}
+	return references
+}
+
+func (u *Unstructured) SetReferences(references []*metav1.OwnerReference) {
+	if references == nil {
+		RemoveNestedField(u.Object, "metadata", "references")
+		return
+	}
+
+	values := make([]any, 0, len(references))
+	for _, ref := range references {
+		if ref == nil {
+			continue
+		}
+		values = append(values, map[string]any{
+			"apiVersion": ref.ApiVersion,
+			"kind":       ref.Kind,
+			"name":       ref.Name,
+			"uid":        ref.Uid,
+		})
+	}
+	u.setNestedField(values, "metadata", "references")
+}
+
+func (u *Unstructured) GetFinalizers() []string {
+	return getNestedStringSlice(u.Object, "metadata", "finalizers")
+}
+
+func (u *Unstructured) SetFinalizers(finalizers []string) {
+	if finalizers == nil {
+		RemoveNestedField(u.Object, "metadata", "finalizers")
+		return
+	}
+
+	values := make([]any, 0, len(finalizers))
+	for _, finalizer := range finalizers {
+		values = append(values, finalizer)
+	}
+	u.setNestedField(values, "metadata", "finalizers")
+}
+
+func (u *Unstructured) GetGeneration() int64 {
+	return getNestedInt64(u.Object, "metadata", "generation")
+}
+
+func (u *Unstructured) SetGeneration(generation int64) {
+	u.setNestedField(generation, "metadata", "generation")
+}
+
+func (u *Unstructured) setNestedField(value any, fields ...string) {
+	if u.Object == nil {
+		u.Object = make(map[string]any)
+	}
+	_ = SetNestedField(u.Object, value, fields...)
+}
+
+func (u *Unstructured) setNestedStringMap(value map[string]string, fields ...string) {
+	if value == nil {
+		RemoveNestedField(u.Object, fields...)
+		return
+	}
+
+	m := make(map[string]any, len(value))
+	for k, v := range value {
+		m[k] = v
+	}
+	u.setNestedField(m, fields...)
+}
+
+var (
+	_ Object        = (*UnstructuredList)(nil)
+	_ metav1.Lister = (*UnstructuredList)(nil)
+)
+
+// UnstructuredList allows lists that do not have Golang structs registered to be manipulated generically.
+type UnstructuredList struct {
+	Object map[string]any
+
+	// Items is a list of unstructured objects.
+	Items []Unstructured `json:"items"`
+}
+
+func (u *UnstructuredList) GetObjectKind() schema.ObjectKind {
+	return u
+}
+
+func (u *UnstructuredList) SetGroupVersionKind(gvk schema.GroupVersionKind) {
+	u.setNestedField(gvk.APIGroup(), "apiVersion")
+	u.setNestedField(gvk.Kind, "kind")
+}
+
+func (u *UnstructuredList) GroupVersionKind() schema.GroupVersionKind {
+	gv, err := schema.ParseGroupVersion(getNestedString(u.Object, "apiVersion"))
+	if err != nil {
+		return schema.GroupVersionKind{}
+	}
+	return gv.WithKind(getNestedString(u.Object, "kind"))
+}
+
+func (u *UnstructuredList) DeepCopyObject() Object {
+	return u.DeepCopy()
+}
+
+func (u *UnstructuredList) DeepFromObject(o Object) {
+	if in, ok := o.(*UnstructuredList); ok {
+		*u = *in.DeepCopy()
+	}
+}
+
+// DeepCopy creates a new UnstructuredList
+func (u *UnstructuredList) DeepCopy() *UnstructuredList {
+	if u == nil {
+		return nil
+	}
+	out := new(UnstructuredList)
+	if u.Object != nil {
+		out.Object = DeepCopyJSONValue(u.Object).(map[string]any)
+	}
+	if u.Items != nil {
+		out.Items = make([]Unstructured, len(u.Items))
+		for i := range u.Items {
+			out.Items[i] = *u.Items[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+func (u *UnstructuredList) MarshalJSON() ([]byte, error) {
+	out := make(map[string]any, len(u.Object)+1)
+	for k, v := range u.Object {
+		out[k] = v
+	}
+	items := make([]any, 0, len(u.Items))
+	for _, item := range u.Items {
+		items = append(items, item.Object)
+	}
+	out["items"] = items
+	return json.Marshal(out)
+}
+
+func (u *UnstructuredList) UnmarshalJSON(data []byte) error {
+	m, err := unmarshalMap(data)
+	if err != nil {
+		return err
+	}
+
+	items, _ := m["items"].([]any)
+	delete(m, "items")
+	u.Object = m
+	u.Items = make([]Unstructured, 0, len(items))
+	for i, item := range items {
+		im, ok := item.(map[string]any)
+		if !ok {
+			return fmt.Errorf("items[%d] is %T, not an object", i, item)
+		}
+		u.Items = append(u.Items, Unstructured{Object: im})
+	}
+	return nil
+}
+
+func (u *UnstructuredList) GetResourceVersion() string {
+	return getNestedString(u.Object, "metadata", "resourceVersion")
+}
+
+func (u *UnstructuredList) SetResourceVersion(version string) {
+	u.setNestedField(version, "metadata", "resourceVersion")
+}
+
+func (u *UnstructuredList) GetPage() int32 {
+	return int32(getNestedInt64(u.Object, "metadata", "page"))
+}
+
+func (u *UnstructuredList) SetPage(page int32) {
+	u.setNestedField(int64(page), "metadata", "page")
+}
+
+func (u *UnstructuredList) GetSize() int32 {
+	return int32(getNestedInt64(u.Object, "metadata", "size"))
+}
+
+func (u *UnstructuredList) SetSize(s int32) {
+	u.setNestedField(int64(s), "metadata", "size")
+}
+
+func (u *UnstructuredList) GetTotal() int64 {
+	return getNestedInt64(u.Object, "metadata", "total")
+}
+
+func (u *UnstructuredList) SetTotal(total int64) {
+	u.setNestedField(total, "metadata", "total")
+}
+
+func (u *UnstructuredList) GetContinue() string {
+	return getNestedString(u.Object, "metadata", "continue")
+}
+
+func (u *UnstructuredList) SetContinue(c string) {
+	u.setNestedField(c, "metadata", "continue")
+}
+
+func (u *UnstructuredList) setNestedField(value any, fields ...string) {
+	if u.Object == nil {
+		u.Object = make(map[string]any)
+	}
+	_ = SetNestedField(u.Object, value, fields...)
+}
+
+// ToUnstructured converts a typed Object to Unstructured, the TypeMeta is filled
+// by the Scheme when it is empty.
+func ToUnstructured(scheme Scheme, obj Object) (*Unstructured, error) {
+	if u, ok := obj.(*Unstructured); ok {
+		return u.DeepCopy(), nil
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &Unstructured{}
+	if err = u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	if u.GetKind() == "" {
+		gvk, err := scheme.ObjectKind(obj)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %T", err, obj)
+		}
+		u.SetGroupVersionKind(gvk)
+	}
+
+	return u, nil
+}
+
+// FromUnstructured converts Unstructured to the typed Object registered in Scheme
+func FromUnstructured(scheme Scheme, u *Unstructured) (Object, error) {
+	gvk := u.GroupVersionKind()
+	out, err := scheme.New(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, gvk)
+	}
+
+	data, err := json.Marshal(u.Object)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	out.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return out, nil
+}
+
+// NestedField returns the value of a nested field. Returns false if value is not found.
+func NestedField(obj map[string]any, fields ...string) (any, bool) {
+	var value any = obj
+	for _, field := range fields {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		value, ok = m[field]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// SetNestedField sets the value of a nested field, the missing maps are created.
+func SetNestedField(obj map[string]any, value any, fields ...string) error {
+	m := obj
+	for i, field := range fields[:len(fields)-1] {
+		if v, ok := m[field]; ok && v != nil {
+			next, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("value cannot be set because %v is not a map[string]any", strings.Join(fields[:i+1], "."))
+			}
+			m = next
+		} else {
+			next := make(map[string]any)
+			m[field] = next
+			m = next
+		}
+	}
+	m[fields[len(fields)-1]] = value
+	return nil
+}
+
+// RemoveNestedField removes the nested field.
+func RemoveNestedField(obj map[string]any, fields ...string) {
+	m := obj
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := m[field].(map[string]any)
+		if !ok {
+			return
+		}
+		m = next
+	}
+	delete(m, fields[len(fields)-1])
+}
+
+// DeepCopyJSONValue deep copies the passed value, assuming it is a valid JSON representation.
+func DeepCopyJSONValue(x any) any {
+	switch x := x.(type) {
+	case map[string]any:
+		if x == nil {
+			return x
+		}
+		clone := make(map[string]any, len(x))
+		for k, v := range x {
+			clone[k] = DeepCopyJSONValue(v)
+		}
+		return clone
+	case []any:
+		if x == nil {
+			return x
+		}
+		clone := make([]any, len(x))
+		for i, v := range x {
+			clone[i] = DeepCopyJSONValue(v)
+		}
+		return clone
+	default:
+		return x
+	}
+}
+
+func getNestedString(obj map[string]any, fields ...string) string {
+	v, _ := NestedField(obj, fields...)
+	s, _ := v.(string)
+	return s
+}
+
+func getNestedInt64(obj map[string]any, fields ...string) int64 {
+	v, _ := NestedField(obj, fields...)
+	n, _ := toInt64(v)
+	return n
+}
+
+func getNestedStringMap(obj map[string]any, fields ...string) map[string]string {
+	v, _ := NestedField(obj, fields...)
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, vv := range m {
+		if s, ok := vv.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
+func getNestedStringSlice(obj map[string]any, fields ...string) []string {
+	v, _ := NestedField(obj, fields...)
+	values, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, vv := range values {
+		if s, ok := vv.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case float64:
+		if n == float64(int64(n)) {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+// unmarshalMap decodes JSON object, the integers are kept as int64
+func unmarshalMap(data []byte) (map[string]any, error) {
+	decoder := stdjson.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	m := make(map[string]any)
+	if err := decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+	return convertNumber(m).(map[string]any), nil
+}
+
+func convertNumber(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for k, item := range vv {
+			vv[k] = convertNumber(item)
+		}
+		return vv
+	case []any:
+		for i := range vv {
+			vv[i] = convertNumber(vv[i])
+		}
+		return vv
+	case stdjson.Number:
+		if n, err := vv.Int64(); err == nil {
+			return n
+		}
+		f, _ := vv.Float64()
+		return f
+	default:
+		return vv
+	}
+}
@@ -0,0 +1,114 @@
+package runtime
+
+import (
+	"reflect"
+	"testing"
+
+	json "github.com/json-iterator/go"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+)
+
+type TestMetaObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Replicas          int64 `json:"replicas"`
+}
+
+func (t *TestMetaObj) DeepCopyObject() Object {
+	out := new(TestMetaObj)
+	*out = *t
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return out
+}
+
+func (t *TestMetaObj) DeepFromObject(o Object) {
+	*t = *o.DeepCopyObject().(*TestMetaObj)
+}
+
+func TestUnstructured(t *testing.T) {
+	gv := schema.GroupVersion{Group: "test", Version: "v1"}
+	scheme := NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestMetaObj{}); err != nil {
+		t.Fatal(err)
+	}
+
+	in := &TestMetaObj{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "o1",
+			CreationTimestamp: 1700000000000000001,
+			Labels:            map[string]string{"a": "b"},
+			References:        []*metav1.OwnerReference{{Kind: "Owner", Name: "p"}},
+			Finalizers:        []string{"test/cleanup"},
+			Generation:        2,
+		},
+		Replicas: 3,
+	}
+
+	u, err := ToUnstructured(scheme, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GroupVersionKind() != gv.WithKind("TestMetaObj") {
+		t.Fatalf("unexpected gvk %v", u.GroupVersionKind())
+	}
+	if u.GetName() != "o1" || u.GetCreationTimestamp() != in.CreationTimestamp || u.GetLabels()["a"] != "b" {
+		t.Fatalf("unexpected metadata %v", u.Object["metadata"])
+	}
+	if refs := u.GetReferences(); len(refs) != 1 || refs[0].Name != "p" {
+		t.Fatalf("unexpected references %v", refs)
+	}
+
+	if finalizers := u.GetFinalizers(); !reflect.DeepEqual(finalizers, []string{"test/cleanup"}) {
+		t.Fatalf("unexpected finalizers %v", finalizers)
+	}
+	if u.GetGeneration() != 2 {
+		t.Fatalf("unexpected generation %d", u.GetGeneration())
+	}
+
+	u.SetNamespace("default")
+	u.SetLabels(map[string]string{"c": "d"})
+	u.SetFinalizers(nil)
+	if err = SetNestedField(u.Object, int64(5), "replicas"); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := FromUnstructured(scheme, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := out.(*TestMetaObj)
+	if obj.Namespace != "default" || obj.Replicas != 5 || !reflect.DeepEqual(map[string]string(obj.Labels), map[string]string{"c": "d"}) || len(obj.Finalizers) != 0 {
+		t.Fatalf("unexpected object %#v", obj)
+	}
+
+	cp := u.DeepCopy()
+	cp.SetName("o2")
+	if u.GetName() != "o1" {
+		t.Fatal("DeepCopy shares data")
+	}
+}
+
+func TestUnstructuredList_JSON(t *testing.T) {
+	data := []byte(`{"apiVersion":"test/v1","kind":"TestMetaObjList","metadata":{"total":2},"items":[{"metadata":{"name":"a"}},{"metadata":{"name":"b"}}]}`)
+
+	list := &UnstructuredList{}
+	if err := json.Unmarshal(data, list); err != nil {
+		t.Fatal(err)
+	}
+	if list.GetTotal() != 2 || len(list.Items) != 2 || list.Items[1].GetName() != "b" {
+		t.Fatalf("unexpected list %#v", list)
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again := &UnstructuredList{}
+	if err = json.Unmarshal(b, again); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(list, again) {
+		t.Fatalf("got %#v, want %#v", again, list)
+	}
+}
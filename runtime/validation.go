@@ -0,0 +1,187 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/vine-io/apimachinery/validation/field"
+)
+
+// ValidateFunc validates Object, returns field.ErrorList when Object is invalid
+type ValidateFunc func(obj Object) field.ErrorList
+
+// FieldValidator is implemented by the types which validate themselves into field.ErrorList,
+// e.g. metav1.ObjectMeta. The errors are qualified by fldPath, nil fldPath means the root.
+type FieldValidator interface {
+	ValidateFields(fldPath *field.Path) field.ErrorList
+}
+
+// validator is implemented by the types generated by proto-gen-validator, whose errors are
+// only messages and parsed by toFieldErrors
+type validator interface {
+	Validate() error
+}
+
+// AddTypeValidationFunc adds ValidateFunc of the type, returns ErrSchemeFrozen when the Scheme is frozen
+func (s *SimpleScheme) AddTypeValidationFunc(srcType Object, fn ValidateFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+	rt := typeKey(srcType)
+	s.validateFuncs[rt] = append(s.validateFuncs[rt], fn)
+	return nil
+}
+
+// AddGlobalValidationFunc adds ValidateFunc for all types, returns ErrSchemeFrozen when the Scheme is frozen
+func (s *SimpleScheme) AddGlobalValidationFunc(fn ValidateFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frozen {
+		return ErrSchemeFrozen
+	}
+	s.gValidateFuncs = append(s.gValidateFuncs, fn)
+	return nil
+}
+
+// Validate calls FieldValidator of Object (or its fields and embedded metadata), and the Validate() of Object generated
+// by proto-gen-validator if present, then global ValidateFuncs and the ValidateFuncs of the type.
+// All errors are aggregated into one field.ErrorList.
+func (s *SimpleScheme) Validate(obj Object) field.ErrorList {
+	errs := validateFields(obj)
+	if v, ok := obj.(validator); ok {
+		errs = append(errs, toFieldErrors(v.Validate())...)
+	}
+
+	s.mu.RLock()
+	funcs := make([]ValidateFunc, 0, len(s.gValidateFuncs))
+	funcs = append(funcs, s.gValidateFuncs...)
+	funcs = append(funcs, s.validateFuncs[typeKey(obj)]...)
+	s.mu.RUnlock()
+
+	for _, fn := range funcs {
+		errs = append(errs, fn(obj)...)
+	}
+
+	return errs
+}
+
+// validateFields calls FieldValidator of the object. The object not implementing FieldValidator by itself
+// is validated by its fields implementing FieldValidator, the errors are qualified by the json names of
+// fields, e.g. "metadata.name" of the field Metadata tagged with json:"metadata". The embedded fields,
+// e.g. metav1.ObjectMeta, are validated as well, whose errors are at the root unless they are tagged.
+func validateFields(obj Object) field.ErrorList {
+	errs := field.ErrorList{}
+	rv := reflect.ValueOf(obj)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		if v, ok := obj.(FieldValidator); ok {
+			errs = append(errs, v.ValidateFields(nil)...)
+		}
+		return errs
+	}
+	// the ValidateFields promoted from the embedded field doesn't cover the other fields
+	if v, ok := obj.(FieldValidator); ok && !embedsFieldValidator(rv.Elem().Type()) {
+		return append(errs, v.ValidateFields(nil)...)
+	}
+
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		var fldPath *field.Path
+		switch {
+		case name != "":
+			fldPath = field.NewPath(name)
+		case !sf.Anonymous:
+			fldPath = field.NewPath(sf.Name)
+		}
+
+		fv := rv.Field(i)
+		if fv.Kind() != reflect.Ptr {
+			fv = fv.Addr()
+		} else if fv.IsNil() {
+			continue
+		}
+		if v, ok := fv.Interface().(FieldValidator); ok {
+			errs = append(errs, v.ValidateFields(fldPath)...)
+		}
+	}
+	return errs
+}
+
+var fieldValidatorType = reflect.TypeOf((*FieldValidator)(nil)).Elem()
+
+// embedsFieldValidator checks if any embedded field of the struct type implements FieldValidator
+func embedsFieldValidator(rt reflect.Type) bool {
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.Anonymous {
+			continue
+		}
+		if sf.Type.Implements(fieldValidatorType) || sf.Type.Kind() != reflect.Ptr && reflect.PtrTo(sf.Type).Implements(fieldValidatorType) {
+			return true
+		}
+	}
+	return false
+}
+
+// validatorErrRegexp matches the message generated by proto-gen-validator, e.g. "field 'spec.name' is required"
+var validatorErrRegexp = regexp.MustCompile(`^field '([^']*)' (.+)$`)
+
+// toFieldErrors converts error to field.ErrorList
+func toFieldErrors(err error) field.ErrorList {
+	if err == nil {
+		return nil
+	}
+
+	var list field.ErrorList
+	if errors.As(err, &list) {
+		return list
+	}
+	var fe *field.Error
+	if errors.As(err, &fe) {
+		return field.ErrorList{fe}
+	}
+
+	errs := field.ErrorList{}
+	for _, part := range strings.Split(err.Error(), "; ") {
+		if matches := validatorErrRegexp.FindStringSubmatch(part); matches != nil {
+			errs = append(errs, &field.Error{Type: field.ErrorTypeInvalid, Field: matches[1], Detail: matches[2]})
+			continue
+		}
+		errs = append(errs, &field.Error{Type: field.ErrorTypeInvalid, Detail: part})
+	}
+	return errs
+}
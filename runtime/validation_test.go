@@ -0,0 +1,214 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/validation/field"
+)
+
+type TestValidObj struct {
+	TestObj
+	Name     string
+	Replicas int32
+}
+
+func (t *TestValidObj) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("field 'name' is required")
+	}
+	return nil
+}
+
+func TestSimpleScheme_Validate(t *testing.T) {
+	scheme := NewScheme()
+	if err := scheme.AddKnownTypes(gv, &TestValidObj{}); err != nil {
+		t.Fatal(err)
+	}
+
+	scheme.AddGlobalValidationFunc(func(obj Object) field.ErrorList {
+		if obj.GetObjectKind().GroupVersionKind().Kind == "" {
+			return field.ErrorList{field.Required(field.NewPath("kind"), "")}
+		}
+		return nil
+	})
+	scheme.AddTypeValidationFunc(&TestValidObj{}, func(obj Object) field.ErrorList {
+		errs := field.ErrorList{}
+		if o := obj.(*TestValidObj); o.Replicas < 0 {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "replicas"), o.Replicas, "must be greater than or equal to 0"))
+		}
+		return errs
+	})
+
+	errs := scheme.Validate(&TestValidObj{Replicas: -1})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	if errs[0].Field != "name" || errs[0].Type != field.ErrorTypeInvalid {
+		t.Fatalf("unexpected error %v", errs[0])
+	}
+	if errs[2].Field != "spec.replicas" || errs[2].BadValue != int32(-1) {
+		t.Fatalf("unexpected error %v", errs[2])
+	}
+	t.Log(errs.ToAggregate())
+
+	obj := &TestValidObj{Name: "v1"}
+	scheme.Default(obj)
+	if errs = scheme.Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if errs.ToAggregate() != nil {
+		t.Fatal("empty ErrorList must be aggregated into nil")
+	}
+}
+
+// testSpec validates itself into field.ErrorList
+type testSpec struct {
+	Replicas int32
+}
+
+func (s *testSpec) ValidateFields(fldPath *field.Path) field.ErrorList {
+	if s.Replicas < 0 {
+		return field.ErrorList{field.Invalid(fldPath.Child("replicas"), s.Replicas, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
+type TestFieldObj struct {
+	TestObj
+	Spec    testSpec  `json:"spec"`
+	Status  *testSpec `json:"status,omitempty"`
+	Ignored testSpec  `json:"-"`
+}
+
+func (t *TestFieldObj) Validate() error {
+	return fmt.Errorf("legacy message")
+}
+
+type TestSelfFieldObj struct {
+	TestObj
+	Spec testSpec `json:"spec"`
+}
+
+func (t *TestSelfFieldObj) ValidateFields(fldPath *field.Path) field.ErrorList {
+	return field.ErrorList{field.Required(fldPath.Child("self"), "")}
+}
+
+func TestSimpleScheme_ValidateFields(t *testing.T) {
+	scheme := NewScheme()
+
+	errs := scheme.Validate(&TestFieldObj{Spec: testSpec{Replicas: -1}, Ignored: testSpec{Replicas: -1}})
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if e := errs[0]; e.Field != "spec.replicas" || e.Type != field.ErrorTypeInvalid || e.BadValue != int32(-1) {
+		t.Fatalf("unexpected error %#v", e)
+	}
+	// the message of legacy validator
+	if e := errs[1]; e.Field != "" || e.Detail != "legacy message" {
+		t.Fatalf("unexpected error %#v", e)
+	}
+
+	errs = scheme.Validate(&TestFieldObj{Status: &testSpec{Replicas: -2}})
+	if len(errs) != 2 || errs[0].Field != "status.replicas" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	// the object implementing FieldValidator validates its fields itself
+	errs = scheme.Validate(&TestSelfFieldObj{Spec: testSpec{Replicas: -1}})
+	if len(errs) != 1 || errs[0].Field != "self" || errs[0].Type != field.ErrorTypeRequired {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
+
+type TestMetadataObj struct {
+	TestObj
+	Metadata metav1.ObjectMeta `json:"metadata"`
+}
+
+func TestSimpleScheme_ValidateMeta(t *testing.T) {
+	scheme := NewScheme()
+
+	errs := scheme.Validate(&TestMetadataObj{Metadata: metav1.ObjectMeta{Name: "Foo"}})
+	if len(errs) != 1 || errs[0].Field != "metadata.name" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if errs = scheme.Validate(&TestMetadataObj{Metadata: metav1.ObjectMeta{Name: "foo"}}); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
+
+// TestEmbeddedMetaObj is promoted ValidateFields of metav1.ObjectMeta
+type TestEmbeddedMetaObj struct {
+	TestObj
+	metav1.ObjectMeta
+	Spec testSpec `json:"spec"`
+}
+
+type TestTaggedMetaObj struct {
+	TestObj
+	metav1.EntityMeta `json:"metadata"`
+	Spec              testSpec `json:"spec"`
+}
+
+func TestSimpleScheme_ValidateEmbeddedMeta(t *testing.T) {
+	scheme := NewScheme()
+
+	tests := []struct {
+		name   string
+		obj    Object
+		fields []string
+	}{
+		{name: "embedded", obj: &TestEmbeddedMetaObj{ObjectMeta: metav1.ObjectMeta{Name: "Foo"}, Spec: testSpec{Replicas: -1}}, fields: []string{"name", "spec.replicas"}},
+		{name: "embedded valid", obj: &TestEmbeddedMetaObj{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}},
+		{name: "tagged", obj: &TestTaggedMetaObj{Spec: testSpec{Replicas: -1}}, fields: []string{"metadata.name", "spec.replicas"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := scheme.Validate(tt.obj)
+			got := make([]string, 0, len(errs))
+			for _, e := range errs {
+				got = append(got, e.Field)
+			}
+			if len(got) != len(tt.fields) || len(got) != 0 && !reflect.DeepEqual(got, tt.fields) {
+				t.Fatalf("Validate() = %v, want errors of %v", errs, tt.fields)
+			}
+		})
+	}
+}
+
+// valueObj implements Object by value receivers
+type valueObj struct {
+	Name string
+}
+
+func (o valueObj) GetObjectKind() schema.ObjectKind { return schema.EmptyObjectKind }
+
+func (o valueObj) DeepCopyObject() Object { return o }
+
+func (o valueObj) DeepFromObject(Object) {}
+
+func TestSimpleScheme_TypeFuncKey(t *testing.T) {
+	scheme := NewScheme()
+
+	validated, defaulted := 0, 0
+	scheme.AddTypeValidationFunc(valueObj{}, func(obj Object) field.ErrorList {
+		validated++
+		return nil
+	})
+	scheme.AddTypeDefaultingFunc(valueObj{}, func(src Object, gvk schema.GroupVersionKind) Object {
+		defaulted++
+		return src
+	})
+
+	for _, obj := range []Object{valueObj{}, &valueObj{}} {
+		scheme.Validate(obj)
+		scheme.Default(obj)
+	}
+	if validated != 2 || defaulted != 2 {
+		t.Fatalf("validated %d times, defaulted %d times, want 2", validated, defaulted)
+	}
+}
@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package fields implements the selector of object fields.
+package fields
+
+import (
+	"sort"
+	"strings"
+)
+
+// Fields allows you to present fields independently of their storage
+type Fields interface {
+	// Has returns whether the provided field exists
+	Has(field string) bool
+	// Get returns the value for the provided field
+	Get(field string) string
+}
+
+// Set is a map of field:value, it implements Fields
+type Set map[string]string
+
+// Has returns whether the provided field exists in the map
+func (ls Set) Has(field string) bool {
+	_, exists := ls[field]
+	return exists
+}
+
+// Get returns the value in the map for the provided field
+func (ls Set) Get(field string) string {
+	return ls[field]
+}
+
+// String returns all fields listed as a human-readable string, e.g. "a=b,c=d"
+func (ls Set) String() string {
+	selector := make([]string, 0, len(ls))
+	for key, value := range ls {
+		selector = append(selector, key+"="+EscapeValue(value))
+	}
+	sort.Strings(selector)
+	return strings.Join(selector, ",")
+}
+
+// AsSelector converts fields into a Selector which matches the same fields
+func (ls Set) AsSelector() Selector {
+	return SelectorFromSet(ls)
+}
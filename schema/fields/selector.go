@@ -0,0 +1,245 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fields
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Operator is the relationship between the field and value of Requirement
+type Operator string
+
+const (
+	Equals       Operator = "="
+	DoubleEquals Operator = "=="
+	NotEquals    Operator = "!="
+)
+
+// Requirement contains a field, an operator and a value, e.g. "metadata.name=foo"
+type Requirement struct {
+	Field    string
+	Operator Operator
+	Value    string
+}
+
+// Matches returns true if the Requirement matches the input Fields
+func (r Requirement) Matches(ls Fields) bool {
+	switch r.Operator {
+	case Equals, DoubleEquals:
+		return ls.Get(r.Field) == r.Value
+	case NotEquals:
+		return ls.Get(r.Field) != r.Value
+	default:
+		return false
+	}
+}
+
+// String returns a human-readable string of Requirement
+func (r Requirement) String() string {
+	return r.Field + string(r.Operator) + EscapeValue(r.Value)
+}
+
+// Selector represents a field selector
+type Selector interface {
+	// Matches returns true if this selector matches the given set of fields
+	Matches(Fields) bool
+	// Empty returns true if this selector does not restrict the selection space
+	Empty() bool
+	// String returns a human-readable string that represents this selector
+	String() string
+	// Requirements returns the requirements of selector
+	Requirements() []Requirement
+}
+
+type andTerm []Requirement
+
+// Everything returns a selector that matches all fields
+func Everything() Selector {
+	return andTerm{}
+}
+
+// OneTermEqualSelector returns an object that matches objects where one field equals one value
+func OneTermEqualSelector(k, v string) Selector {
+	return andTerm{{Field: k, Operator: Equals, Value: v}}
+}
+
+// OneTermNotEqualSelector returns an object that matches objects where one field does not equal one value
+func OneTermNotEqualSelector(k, v string) Selector {
+	return andTerm{{Field: k, Operator: NotEquals, Value: v}}
+}
+
+// AndSelectors creates a selector that is the logical AND of all the given selectors
+func AndSelectors(selectors ...Selector) Selector {
+	out := andTerm{}
+	for _, s := range selectors {
+		out = append(out, s.Requirements()...)
+	}
+	return out
+}
+
+// SelectorFromSet returns a Selector which will match exactly the given Set
+func SelectorFromSet(ls Set) Selector {
+	out := make(andTerm, 0, len(ls))
+	for field, value := range ls {
+		out = append(out, Requirement{Field: field, Operator: Equals, Value: value})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+func (t andTerm) Matches(ls Fields) bool {
+	for _, r := range t {
+		if !r.Matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t andTerm) Empty() bool {
+	return len(t) == 0
+}
+
+func (t andTerm) String() string {
+	terms := make([]string, 0, len(t))
+	for _, r := range t {
+		terms = append(terms, r.String())
+	}
+	return strings.Join(terms, ",")
+}
+
+func (t andTerm) Requirements() []Requirement {
+	return append([]Requirement{}, t...)
+}
+
+// ParseSelector takes a string representing a selector and returns a Selector, or an error.
+// The selector is a comma separated list of "field=value", "field==value" or "field!=value",
+// the characters '\', ',' and '=' in value are escaped by '\', e.g. "metadata.name=foo,status.code!=1"
+func ParseSelector(selector string) (Selector, error) {
+	out := andTerm{}
+	if len(strings.TrimSpace(selector)) == 0 {
+		return out, nil
+	}
+
+	for _, part := range splitTerms(selector) {
+		field, op, value, ok := splitTerm(part)
+		if !ok {
+			return nil, fmt.Errorf("invalid selector: '%s'; can't understand '%s'", selector, part)
+		}
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return nil, fmt.Errorf("invalid selector: '%s'; field is empty", selector)
+		}
+		unescaped, err := UnescapeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: '%s': %w", selector, err)
+		}
+		out = append(out, Requirement{Field: field, Operator: op, Value: unescaped})
+	}
+	return out, nil
+}
+
+// splitTerms returns the comma-separated terms contained in the given selector, the escaped commas are kept
+func splitTerms(selector string) []string {
+	terms := make([]string, 0, 1)
+	start, escaped := 0, false
+	for i, c := range selector {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == ',':
+			terms = append(terms, selector[start:i])
+			start = i + 1
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+// splitTerm returns the field, operator and escaped value of term
+func splitTerm(term string) (string, Operator, string, bool) {
+	for i := 0; i < len(term); i++ {
+		switch {
+		case strings.HasPrefix(term[i:], string(NotEquals)):
+			return term[:i], NotEquals, term[i+2:], true
+		case strings.HasPrefix(term[i:], string(DoubleEquals)):
+			return term[:i], DoubleEquals, term[i+2:], true
+		case strings.HasPrefix(term[i:], string(Equals)):
+			return term[:i], Equals, term[i+1:], true
+		}
+	}
+	return "", "", "", false
+}
+
+var valueEscaper = strings.NewReplacer(
+	// escape \ characters
+	`\`, `\\`,
+	// then escape , and = characters to allow unambiguous parsing of the value in a fieldSelector
+	`,`, `\,`,
+	`=`, `\=`,
+)
+
+// EscapeValue escapes an arbitrary literal string for use as a fieldSelector value
+func EscapeValue(s string) string {
+	return valueEscaper.Replace(s)
+}
+
+// UnescapeValue unescapes a fieldSelector value and returns the original literal value.
+// May return the original string if it contains no escaped or special characters.
+func UnescapeValue(s string) (string, error) {
+	if !strings.ContainsAny(s, `\,=`) {
+		return s, nil
+	}
+
+	v := strings.Builder{}
+	escaped := false
+	for _, c := range s {
+		if escaped {
+			switch c {
+			case '\\', ',', '=':
+				v.WriteRune(c)
+				escaped = false
+			default:
+				return "", fmt.Errorf("invalid escape sequence '\\%c'", c)
+			}
+			continue
+		}
+
+		switch c {
+		case '\\':
+			escaped = true
+		case ',', '=':
+			return "", fmt.Errorf("unescaped '%c' in value", c)
+		default:
+			v.WriteRune(c)
+		}
+	}
+
+	if escaped {
+		return "", fmt.Errorf("unterminated escape sequence")
+	}
+	return v.String(), nil
+}
@@ -0,0 +1,189 @@
+package fields
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []Requirement
+		wantErr bool
+	}{
+		{in: "", want: []Requirement{}},
+		{
+			in: "metadata.name=foo,metadata.namespace!=kube,status.code==1",
+			want: []Requirement{
+				{Field: "metadata.name", Operator: Equals, Value: "foo"},
+				{Field: "metadata.namespace", Operator: NotEquals, Value: "kube"},
+				{Field: "status.code", Operator: DoubleEquals, Value: "1"},
+			},
+		},
+		{
+			in:   `metadata.name=a\,b\=c\\d`,
+			want: []Requirement{{Field: "metadata.name", Operator: Equals, Value: `a,b=c\d`}},
+		},
+		{in: "metadata.name=", want: []Requirement{{Field: "metadata.name", Operator: Equals, Value: ""}}},
+		{in: "metadata.name", wantErr: true},
+		{in: "=foo", wantErr: true},
+		{in: `metadata.name=a\b`, wantErr: true},
+		{in: `metadata.name=a=b`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			selector, err := ParseSelector(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reqs := selector.Requirements()
+			if len(reqs) != len(tt.want) {
+				t.Fatalf("Requirements() = %v, want %v", reqs, tt.want)
+			}
+			for i := range reqs {
+				if reqs[i] != tt.want[i] {
+					t.Errorf("Requirements()[%d] = %v, want %v", i, reqs[i], tt.want[i])
+				}
+			}
+
+			again, err := ParseSelector(selector.String())
+			if err != nil || again.String() != selector.String() {
+				t.Errorf("round trip %s: %v, %v", selector, again, err)
+			}
+		})
+	}
+}
+
+func TestSelector_Matches(t *testing.T) {
+	fs := Set{"metadata.name": "foo", "metadata.namespace": "default"}
+
+	tests := []struct {
+		selector Selector
+		want     bool
+	}{
+		{Everything(), true},
+		{OneTermEqualSelector("metadata.name", "foo"), true},
+		{OneTermEqualSelector("metadata.name", "bar"), false},
+		{OneTermNotEqualSelector("metadata.namespace", "kube"), true},
+		{AndSelectors(OneTermEqualSelector("metadata.name", "foo"), OneTermEqualSelector("metadata.namespace", "kube")), false},
+		{SelectorFromSet(Set{"metadata.name": "foo"}), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector.String(), func(t *testing.T) {
+			if got := tt.selector.Matches(fs); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// dryRunDialector renders SQL of mysql without a database
+type dryRunDialector struct{}
+
+func (d dryRunDialector) Name() string { return "mysql" }
+
+func (d dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	return nil
+}
+
+func (d dryRunDialector) Migrator(db *gorm.DB) gorm.Migrator { return nil }
+
+func (d dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (d dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (d dryRunDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	writer.WriteByte('?')
+}
+
+func (d dryRunDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteString("`" + str + "`")
+}
+
+func (d dryRunDialector) Explain(sql string, vars ...interface{}) string {
+	return logger.ExplainSQL(sql, nil, `'`, vars...)
+}
+
+func TestToExpressions(t *testing.T) {
+	mappings := ObjectMetaMappings("object_meta")
+	mappings["status.code"] = Mapping{Column: "status_code", Convert: Int64Value}
+
+	selector, err := ParseSelector("metadata.name=foo,metadata.namespace!=kube,status.code=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exprs, err := ToExpressions(selector, mappings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := db.Table("tests").Clauses(exprs...).Find(&[]map[string]interface{}{}).Statement
+	sql := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+	want := "SELECT * FROM `tests` WHERE JSON_EXTRACT(`object_meta`,'$.name') = 'foo' AND " +
+		"JSON_EXTRACT(`object_meta`,'$.namespace') <> 'kube' AND `status_code` = 1"
+	if sql != want {
+		t.Errorf("SQL = %s, want %s", sql, want)
+	}
+
+	_, err = ToExpressions(OneTermEqualSelector("spec.unknown", "x"), mappings)
+	if !errors.Is(err, ErrFieldNotSelectable) {
+		t.Errorf("expected ErrFieldNotSelectable, got %v", err)
+	}
+
+	_, err = ToExpressions(OneTermEqualSelector("status.code", "x"), mappings)
+	if err == nil || !strings.Contains(err.Error(), "status.code") {
+		t.Errorf("expected invalid value error, got %v", err)
+	}
+}
+
+func TestToOrderBy(t *testing.T) {
+	mappings := ObjectMetaMappings("object_meta")
+	mappings["status.code"] = Mapping{Column: "status_code", Convert: Int64Value}
+
+	expr, err := ToOrderBy("metadata.name, -status.code", mappings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := gorm.Open(dryRunDialector{}, &gorm.Config{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := db.Table("tests").Clauses(expr).Find(&[]map[string]interface{}{}).Statement
+	sql := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+	want := "SELECT * FROM `tests` ORDER BY JSON_EXTRACT(`object_meta`,'$.name'), `status_code` DESC"
+	if sql != want {
+		t.Errorf("SQL = %s, want %s", sql, want)
+	}
+
+	if expr, err = ToOrderBy("", mappings); err != nil || expr != nil {
+		t.Errorf("ToOrderBy() = %v, %v", expr, err)
+	}
+	if _, err = ToOrderBy("-spec.unknown", mappings); !errors.Is(err, ErrFieldNotSortable) {
+		t.Errorf("expected ErrFieldNotSortable, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fields
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vine-io/apimachinery/storage/dao"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrFieldNotSelectable = fmt.Errorf("field is not selectable")
+	ErrFieldNotSortable   = fmt.Errorf("field is not sortable")
+)
+
+// Mapping describes where a selectable field is stored
+type Mapping struct {
+	// Column is the column of table storing the field
+	Column string
+	// Path is the path of field in the JSON column, empty when Column stores the field directly
+	Path []string
+	// Convert converts the value of selector to the type of column, the string is used when nil
+	Convert func(value string) (any, error)
+}
+
+// Mappings maps the field path of selector (e.g. metadata.name) to Mapping
+type Mappings map[string]Mapping
+
+// ObjectMetaMappings returns Mappings of the common fields of metav1.ObjectMeta which is stored
+// as JSON in the given column.
+func ObjectMetaMappings(column string) Mappings {
+	return Mappings{
+		"metadata.name":            {Column: column, Path: []string{"name"}},
+		"metadata.namespace":       {Column: column, Path: []string{"namespace"}},
+		"metadata.uid":             {Column: column, Path: []string{"uid"}},
+		"metadata.generateName":    {Column: column, Path: []string{"generateName"}},
+		"metadata.resourceVersion": {Column: column, Path: []string{"resourceVersion"}},
+	}
+}
+
+// Int64Value converts the value of selector to int64, it's used by Mapping.Convert
+func Int64Value(value string) (any, error) {
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// ToExpressions compiles the Selector into clause.Expression by the given Mappings. It reports
+// ErrFieldNotSelectable when the selector contains a field not in Mappings.
+func ToExpressions(selector Selector, mappings Mappings) ([]clause.Expression, error) {
+	if selector == nil || selector.Empty() {
+		return nil, nil
+	}
+
+	reqs := selector.Requirements()
+	exprs := make([]clause.Expression, 0, len(reqs))
+	for _, r := range reqs {
+		m, ok := mappings[r.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotSelectable, r.Field)
+		}
+
+		var value any = r.Value
+		if m.Convert != nil {
+			var err error
+			value, err = m.Convert(r.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of field %s: %v", r.Field, err)
+			}
+		}
+
+		op := dao.EqOp
+		if r.Operator == NotEquals {
+			op = dao.NeqOp
+		}
+
+		if len(m.Path) == 0 {
+			exprs = append(exprs, dao.Cond().Op(op).Build(m.Column, value))
+		} else {
+			exprs = append(exprs, dao.JSONQuery(m.Column).Op(op, value, m.Path...))
+		}
+	}
+	return exprs, nil
+}
+
+// ToOrderBy compiles sortBy, the comma separated fields prefixed with '-' for the descending order
+// (e.g. "metadata.name,-status.code"), into clause.OrderBy by the given Mappings. It reports
+// ErrFieldNotSortable when sortBy contains a field not in Mappings, and nil is returned for empty sortBy.
+func ToOrderBy(sortBy string, mappings Mappings) (clause.Expression, error) {
+	exprs := make([]clause.Expression, 0)
+	for _, term := range strings.Split(sortBy, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		field, desc := strings.TrimPrefix(term, "-"), strings.HasPrefix(term, "-")
+		m, ok := mappings[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotSortable, field)
+		}
+
+		var operand any = clause.Column{Name: m.Column}
+		if len(m.Path) != 0 {
+			operand = dao.JSONQuery(m.Column).ExtractKeys(m.Path...)
+		}
+		sql := "?"
+		if desc {
+			sql += " DESC"
+		}
+		exprs = append(exprs, clause.Expr{SQL: sql, Vars: []any{operand}})
+	}
+
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+	return clause.OrderBy{Expression: clause.CommaExpression{Exprs: exprs}}, nil
+}
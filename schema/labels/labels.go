@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package labels implements the selector of labels.
+package labels
+
+import (
+	"sort"
+	"strings"
+)
+
+// Labels allows you to present labels independently of their storage
+type Labels interface {
+	// Has returns whether the provided label exists
+	Has(label string) bool
+	// Get returns the value for the provided label
+	Get(label string) string
+}
+
+// Set is a map of label:value, it implements Labels
+type Set map[string]string
+
+// Has returns whether the provided label exists in the map
+func (ls Set) Has(label string) bool {
+	_, exists := ls[label]
+	return exists
+}
+
+// Get returns the value in the map for the provided label
+func (ls Set) Get(label string) string {
+	return ls[label]
+}
+
+// String returns all labels listed as a human-readable string, e.g. "a=b,c=d"
+func (ls Set) String() string {
+	selector := make([]string, 0, len(ls))
+	for key, value := range ls {
+		selector = append(selector, key+"="+value)
+	}
+	sort.Strings(selector)
+	return strings.Join(selector, ",")
+}
+
+// AsSelector converts labels into a Selector which matches the same labels
+func (ls Set) AsSelector() Selector {
+	return SelectorFromSet(ls)
+}
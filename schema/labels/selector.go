@@ -0,0 +1,377 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package labels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/validation"
+)
+
+// Operator is the relationship between the key and values of Requirement
+type Operator string
+
+const (
+	DoesNotExist Operator = "!"
+	Equals       Operator = "="
+	DoubleEquals Operator = "=="
+	In           Operator = "in"
+	NotEquals    Operator = "!="
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+)
+
+// Requirement contains a key, an operator and a set of values, e.g. "tier in (web,api)"
+type Requirement struct {
+	key      string
+	operator Operator
+	// values are sorted, so that the String() is stable
+	values []string
+}
+
+// NewRequirement is the constructor for a Requirement. It reports error when:
+//   - the key is not a qualified name, e.g. "app" or "example.com/tier"
+//   - the operator is In or NotIn, and values is empty
+//   - the operator is Equals, DoubleEquals or NotEquals, and values has not exactly one element
+//   - the operator is Exists or DoesNotExist, and values is not empty
+//   - any value contains the reserved characters of selector syntax
+func NewRequirement(key string, op Operator, values []string) (*Requirement, error) {
+	if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+		return nil, fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+	}
+
+	switch op {
+	case In, NotIn:
+		if len(values) == 0 {
+			return nil, fmt.Errorf("for 'in', 'notin' operators, values set can't be empty")
+		}
+	case Equals, DoubleEquals, NotEquals:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("exact-match compatibility requires one single value")
+		}
+	case Exists, DoesNotExist:
+		if len(values) != 0 {
+			return nil, fmt.Errorf("values set must be empty for exists and does not exist")
+		}
+	default:
+		return nil, fmt.Errorf("operator '%v' is not recognized", op)
+	}
+
+	for _, value := range values {
+		if err := validateToken(value); err != nil {
+			return nil, fmt.Errorf("invalid label value %q: %v", value, err)
+		}
+	}
+
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return &Requirement{key: key, operator: op, values: sorted}, nil
+}
+
+// Key returns the key of Requirement
+func (r *Requirement) Key() string {
+	return r.key
+}
+
+// Operator returns the operator of Requirement
+func (r *Requirement) Operator() Operator {
+	return r.operator
+}
+
+// Values returns the copy of values of Requirement
+func (r *Requirement) Values() []string {
+	return append([]string{}, r.values...)
+}
+
+func (r *Requirement) hasValue(value string) bool {
+	for _, v := range r.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the Requirement matches the input Labels
+func (r *Requirement) Matches(ls Labels) bool {
+	switch r.operator {
+	case In, Equals, DoubleEquals:
+		return ls.Has(r.key) && r.hasValue(ls.Get(r.key))
+	case NotIn, NotEquals:
+		return !ls.Has(r.key) || !r.hasValue(ls.Get(r.key))
+	case Exists:
+		return ls.Has(r.key)
+	case DoesNotExist:
+		return !ls.Has(r.key)
+	default:
+		return false
+	}
+}
+
+// String returns a human-readable string of Requirement
+func (r *Requirement) String() string {
+	var sb strings.Builder
+	if r.operator == DoesNotExist {
+		sb.WriteString("!")
+	}
+	sb.WriteString(r.key)
+
+	switch r.operator {
+	case Equals, DoubleEquals, NotEquals:
+		sb.WriteString(string(r.operator))
+		sb.WriteString(r.values[0])
+	case In, NotIn:
+		sb.WriteString(" " + string(r.operator) + " (")
+		sb.WriteString(strings.Join(r.values, ","))
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// Selector represents a label selector
+type Selector interface {
+	// Matches returns true if this selector matches the given set of labels
+	Matches(Labels) bool
+	// Empty returns true if this selector does not restrict the selection space
+	Empty() bool
+	// String returns a human-readable string that represents this selector
+	String() string
+	// Add adds requirements to the selector, returns a new Selector
+	Add(r ...Requirement) Selector
+	// Requirements returns the requirements of selector
+	Requirements() []Requirement
+}
+
+type internalSelector []Requirement
+
+// Everything returns a selector that matches all labels
+func Everything() Selector {
+	return internalSelector{}
+}
+
+// NewSelector returns a nil selector
+func NewSelector() Selector {
+	return internalSelector(nil)
+}
+
+func (s internalSelector) Matches(ls Labels) bool {
+	for i := range s {
+		if !s[i].Matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s internalSelector) Empty() bool {
+	return len(s) == 0
+}
+
+func (s internalSelector) String() string {
+	reqs := make([]string, 0, len(s))
+	for i := range s {
+		reqs = append(reqs, s[i].String())
+	}
+	return strings.Join(reqs, ",")
+}
+
+func (s internalSelector) Add(reqs ...Requirement) Selector {
+	out := make(internalSelector, 0, len(s)+len(reqs))
+	out = append(out, s...)
+	out = append(out, reqs...)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].key < out[j].key })
+	return out
+}
+
+func (s internalSelector) Requirements() []Requirement {
+	return append([]Requirement{}, s...)
+}
+
+// SelectorFromSet returns a Selector which will match exactly the given Set
+func SelectorFromSet(ls Set) Selector {
+	reqs := make(internalSelector, 0, len(ls))
+	for key, value := range ls {
+		reqs = append(reqs, Requirement{key: key, operator: Equals, values: []string{value}})
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].key < reqs[j].key })
+	return reqs
+}
+
+// MatchesMeta returns true if the Selector matches the labels of metav1.Meta
+func MatchesMeta(selector Selector, meta metav1.Meta) bool {
+	return selector.Matches(Set(meta.GetLabels()))
+}
+
+// Parse takes a string representing a selector and returns a Selector, or an error.
+// The input will cause an error if it does not follow this form:
+//
+//	<selector-syntax>         ::= <requirement> | <requirement> "," <selector-syntax>
+//	<requirement>             ::= [!] KEY [ <set-based-restriction> | <exact-match-restriction> ]
+//	<set-based-restriction>   ::= "" | <inclusion-exclusion> <value-set>
+//	<inclusion-exclusion>     ::= <inclusion> | <exclusion>
+//	<exclusion>               ::= "notin"
+//	<inclusion>               ::= "in"
+//	<value-set>               ::= "(" <values> ")"
+//	<values>                  ::= VALUE | VALUE "," <values>
+//	<exact-match-restriction> ::= ["="|"=="|"!="] VALUE
+//
+// e.g. "env=prod,tier in (web,api),!deprecated,version notin (1)"
+func Parse(selector string) (Selector, error) {
+	p := &parser{s: selector}
+	reqs, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse selector %q: %w", selector, err)
+	}
+	sort.SliceStable(reqs, func(i, j int) bool { return reqs[i].key < reqs[j].key })
+	return reqs, nil
+}
+
+// reservedChars are the characters with special meaning in selector
+const reservedChars = " \t\n,()=!"
+
+func validateToken(token string) error {
+	if i := strings.IndexAny(token, reservedChars); i >= 0 {
+		return fmt.Errorf("unexpected character %q", token[i])
+	}
+	return nil
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+// peek returns the next character after spaces, 0 at the end
+func (p *parser) peek() byte {
+	p.skipSpaces()
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+// consume consumes the prefix after spaces when it matches
+func (p *parser) consume(prefix string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.s[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+// identifier reads a key or a value, it can be empty
+func (p *parser) identifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(reservedChars, p.s[p.pos]) < 0 {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *parser) parse() (internalSelector, error) {
+	reqs := internalSelector{}
+	if p.peek() == 0 {
+		return reqs, nil
+	}
+
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, *r)
+
+		switch p.peek() {
+		case 0:
+			return reqs, nil
+		case ',':
+			p.pos++
+			if p.peek() == 0 {
+				return nil, fmt.Errorf("found end of selector after ','")
+			}
+		default:
+			return nil, fmt.Errorf("found '%s', expected: ',' or end of selector", p.s[p.pos:])
+		}
+	}
+}
+
+func (p *parser) requirement() (*Requirement, error) {
+	if p.consume("!") {
+		key := p.identifier()
+		if key == "" {
+			return nil, fmt.Errorf("expected label key after '!' at position %d", p.pos)
+		}
+		return NewRequirement(key, DoesNotExist, nil)
+	}
+
+	key := p.identifier()
+	if key == "" {
+		return nil, fmt.Errorf("expected label key at position %d", p.pos)
+	}
+
+	switch {
+	case p.peek() == 0, p.peek() == ',':
+		return NewRequirement(key, Exists, nil)
+	case p.consume(string(DoubleEquals)):
+		return NewRequirement(key, DoubleEquals, []string{p.identifier()})
+	case p.consume(string(NotEquals)):
+		return NewRequirement(key, NotEquals, []string{p.identifier()})
+	case p.consume(string(Equals)):
+		return NewRequirement(key, Equals, []string{p.identifier()})
+	}
+
+	op := Operator(p.identifier())
+	if op != In && op != NotIn {
+		return nil, fmt.Errorf("found '%s', expected: '=', '!=', '==', 'in', 'notin'", op)
+	}
+	if !p.consume("(") {
+		return nil, fmt.Errorf("found '%s', expected: '('", p.s[p.pos:])
+	}
+
+	values := make([]string, 0)
+	for !p.consume(")") {
+		if len(values) != 0 && !p.consume(",") {
+			return nil, fmt.Errorf("found '%s', expected: ',' or ')'", p.s[p.pos:])
+		}
+		values = append(values, p.identifier())
+		if p.eof() {
+			return nil, fmt.Errorf("found end of selector, expected: ')'")
+		}
+	}
+	return NewRequirement(key, op, values)
+}
@@ -0,0 +1,203 @@
+package labels
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "env=prod", want: "env=prod"},
+		{in: "env==prod", want: "env==prod"},
+		{in: "env = prod , tier!=web", want: "env=prod,tier!=web"},
+		{in: "env=prod,tier in (web,api),!deprecated,version notin (1)", want: "!deprecated,env=prod,tier in (api,web),version notin (1)"},
+		{in: "app.io/name", want: "app.io/name"},
+		{in: "env=", want: "env="},
+		{in: "env=prod,", wantErr: true},
+		{in: "tier in ()", wantErr: true},
+		{in: "tier in (web", wantErr: true},
+		{in: "tier within (web)", wantErr: true},
+		{in: "!", wantErr: true},
+		{in: "env=prod tier=web", wantErr: true},
+		{in: "env'=prod", wantErr: true},
+		{in: `"env"=prod`, wantErr: true},
+		{in: "$.env", wantErr: true},
+		{in: "-env=prod", wantErr: true},
+		{in: "a.io/b/c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			selector, err := Parse(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := selector.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelector_Matches(t *testing.T) {
+	ls := Set{"env": "prod", "tier": "web", "version": "2"}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env=dev", false},
+		{"env!=dev", true},
+		{"missing!=dev", true},
+		{"tier in (web,api)", true},
+		{"tier in (api)", false},
+		{"version notin (1)", true},
+		{"version notin (1,2)", false},
+		{"missing notin (1)", true},
+		{"!deprecated", true},
+		{"!env", false},
+		{"env", true},
+		{"deprecated", false},
+		{"env=prod,tier in (web,api),!deprecated,version notin (1)", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			selector, err := Parse(tt.selector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := selector.Matches(ls); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	meta := &metav1.ObjectMeta{Labels: map[string]string{"env": "prod"}}
+	if !MatchesMeta(SelectorFromSet(Set{"env": "prod"}), meta) {
+		t.Error("MatchesMeta() = false")
+	}
+}
+
+var numericPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// dryRunDialector renders SQL of the given dialect without a database
+type dryRunDialector struct {
+	name string
+}
+
+func (d dryRunDialector) Name() string { return d.name }
+
+func (d dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	return nil
+}
+
+func (d dryRunDialector) Migrator(db *gorm.DB) gorm.Migrator { return nil }
+
+func (d dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (d dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (d dryRunDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	if d.name == "postgres" {
+		writer.WriteString("$" + strconv.Itoa(len(stmt.Vars)))
+		return
+	}
+	writer.WriteByte('?')
+}
+
+func (d dryRunDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteString(`"` + str + `"`)
+}
+
+func (d dryRunDialector) Explain(sql string, vars ...interface{}) string {
+	if d.name == "postgres" {
+		return logger.ExplainSQL(sql, numericPlaceholder, `'`, vars...)
+	}
+	return logger.ExplainSQL(sql, nil, `'`, vars...)
+}
+
+func TestToExpressions(t *testing.T) {
+	selector, err := Parse("env=prod,tier in (web,api),!deprecated,version notin (1),app.io/name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		dialect string
+		want    []string
+	}{
+		{
+			dialect: "mysql",
+			want: []string{
+				`NOT JSON_EXTRACT("object_meta",'$.labels.deprecated') IS NOT NULL`,
+				`JSON_EXTRACT("object_meta",'$.labels."app.io/name"') IS NOT NULL`,
+				`JSON_EXTRACT("object_meta",'$.labels.env') = 'prod'`,
+				`(JSON_EXTRACT("object_meta",'$.labels.tier') = 'api' OR JSON_EXTRACT("object_meta",'$.labels.tier') = 'web')`,
+				`(NOT JSON_EXTRACT("object_meta",'$.labels.version') IS NOT NULL OR JSON_EXTRACT("object_meta",'$.labels.version') <> '1')`,
+			},
+		},
+		{
+			dialect: "sqlite",
+			want: []string{
+				`JSON_EXTRACT("object_meta",'$.labels."app.io/name"') IS NOT NULL`,
+				`JSON_EXTRACT("object_meta",'$.labels.env') = 'prod'`,
+			},
+		},
+		{
+			dialect: "postgres",
+			want: []string{
+				`NOT "object_meta"::jsonb -> 'labels' ? 'deprecated'`,
+				`json_extract_path_text("object_meta"::json,'labels','env') = 'prod'`,
+				`"object_meta"::jsonb -> 'labels' ? 'app.io/name'`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			db, err := gorm.Open(dryRunDialector{name: tt.dialect}, &gorm.Config{DryRun: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			exprs := ToExpressions(selector, DefaultColumn, DefaultPath...)
+			stmt := db.Table("tests").Clauses(exprs...).Find(&[]map[string]interface{}{}).Statement
+			sql := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+			for _, want := range tt.want {
+				if !strings.Contains(sql, want) {
+					t.Errorf("SQL %s\ndoes not contain %s", sql, want)
+				}
+			}
+		})
+	}
+
+	if exprs := ToExpressions(Everything(), DefaultColumn, DefaultPath...); exprs != nil {
+		t.Errorf("ToExpressions() of empty selector = %v", exprs)
+	}
+}
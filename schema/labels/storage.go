@@ -0,0 +1,84 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package labels
+
+import (
+	"github.com/vine-io/apimachinery/storage/dao"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultColumn is the column of metav1.ObjectMeta which is stored as JSON
+const DefaultColumn = "object_meta"
+
+// DefaultPath is the path of labels in the JSON of metav1.ObjectMeta
+var DefaultPath = []string{"labels"}
+
+// ToExpressions compiles the Selector into clause.Expression built by dao.JSONQuery, which are
+// supported by mysql, postgres and sqlite. The labels are read from the JSON column at path,
+// e.g. ToExpressions(selector, DefaultColumn, DefaultPath...) for metav1.ObjectMeta.
+func ToExpressions(selector Selector, column string, path ...string) []clause.Expression {
+	if selector == nil || selector.Empty() {
+		return nil
+	}
+
+	reqs := selector.Requirements()
+	exprs := make([]clause.Expression, 0, len(reqs))
+	for i := range reqs {
+		exprs = append(exprs, reqs[i].ToExpression(column, path...))
+	}
+	return exprs
+}
+
+// ToExpression compiles the Requirement into clause.Expression, see ToExpressions
+func (r *Requirement) ToExpression(column string, path ...string) clause.Expression {
+	keys := append(append([]string{}, path...), r.key)
+
+	hasKey := func() clause.Expression {
+		return dao.JSONQuery(column).HasKey(keys...)
+	}
+	equals := func(value string) clause.Expression {
+		return dao.JSONQuery(column).Equals(value, keys...)
+	}
+
+	switch r.operator {
+	case Equals, DoubleEquals:
+		return equals(r.values[0])
+	case In:
+		exprs := make([]clause.Expression, 0, len(r.values))
+		for _, value := range r.values {
+			exprs = append(exprs, equals(value))
+		}
+		return clause.Or(exprs...)
+	case NotEquals, NotIn:
+		exprs := make([]clause.Expression, 0, len(r.values))
+		for _, value := range r.values {
+			exprs = append(exprs, dao.JSONQuery(column).Op(dao.NeqOp, value, keys...))
+		}
+		return clause.Or(clause.Not(hasKey()), clause.And(exprs...))
+	case Exists:
+		return hasKey()
+	case DoesNotExist:
+		return clause.Not(hasKey())
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package schema
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var versionRegex = regexp.MustCompile(`^v([1-9][0-9]*)(?:(alpha|beta)([1-9][0-9]*))?$`)
+
+type versionType int
+
+const (
+	// the order is the maturity of version
+	versionUnknown versionType = iota
+	versionAlpha
+	versionBeta
+	versionGA
+)
+
+type parsedVersion struct {
+	typ   versionType
+	major int
+	minor int
+}
+
+func parseVersion(v string) parsedVersion {
+	submatches := versionRegex.FindStringSubmatch(v)
+	if len(submatches) == 0 {
+		return parsedVersion{typ: versionUnknown}
+	}
+
+	out := parsedVersion{typ: versionGA}
+	out.major, _ = strconv.Atoi(submatches[1])
+	switch submatches[2] {
+	case "alpha":
+		out.typ = versionAlpha
+	case "beta":
+		out.typ = versionBeta
+	}
+	if submatches[3] != "" {
+		out.minor, _ = strconv.Atoi(submatches[3])
+	}
+	return out
+}
+
+// CompareVersionPriority compares the priority of two versions, returns a positive number when v1 has the
+// higher priority, a negative number when v2 has, and 0 when they are equal. The versions like v1, v2beta1
+// and v1alpha1 are ordered by maturity first, then by number:
+//
+//	v10, v2, v1, v11beta2, v10beta3, v3beta1, v12alpha1, v11alpha2, foo1, foo10
+//
+// The other versions have the lowest priority and are ordered by lexicographic order.
+func CompareVersionPriority(v1, v2 string) int {
+	p1, p2 := parseVersion(v1), parseVersion(v2)
+	if p1.typ != p2.typ {
+		return int(p1.typ) - int(p2.typ)
+	}
+	if p1.typ == versionUnknown {
+		switch {
+		case v1 < v2:
+			return 1
+		case v1 > v2:
+			return -1
+		default:
+			return 0
+		}
+	}
+	if p1.major != p2.major {
+		return p1.major - p2.major
+	}
+	return p1.minor - p2.minor
+}
+
+// SortVersions sorts versions by CompareVersionPriority, the highest priority first
+func SortVersions(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return CompareVersionPriority(versions[i], versions[j]) > 0
+	})
+}
+
+// SortGroupVersions sorts schema.GroupVersion of a group by CompareVersionPriority, the highest priority first.
+// The order of groups is kept.
+func SortGroupVersions(gvs []GroupVersion) {
+	groups := map[string]int{}
+	for _, gv := range gvs {
+		if _, ok := groups[gv.Group]; !ok {
+			groups[gv.Group] = len(groups)
+		}
+	}
+
+	sort.SliceStable(gvs, func(i, j int) bool {
+		gi, gj := groups[gvs[i].Group], groups[gvs[j].Group]
+		if gi != gj {
+			return gi < gj
+		}
+		return CompareVersionPriority(gvs[i].Version, gvs[j].Version) > 0
+	})
+}
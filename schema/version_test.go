@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortVersions(t *testing.T) {
+	versions := []string{"v1alpha1", "foo10", "v1", "v1beta1", "v10", "foo1", "v2", "v1beta2", "v11alpha2", "v12alpha1", "v3beta1"}
+	want := []string{"v10", "v2", "v1", "v3beta1", "v1beta2", "v1beta1", "v12alpha1", "v11alpha2", "v1alpha1", "foo1", "foo10"}
+
+	SortVersions(versions)
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("SortVersions() = %v, want %v", versions, want)
+	}
+}
+
+func TestCompareVersionPriority(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		want   int
+	}{
+		{"v2", "v1", 1},
+		{"v1", "v1beta2", 1},
+		{"v1beta2", "v1beta1", 1},
+		{"v1beta1", "v1alpha1", 1},
+		{"v1alpha1", "foo", 1},
+		{"v1", "v1", 0},
+		{"v01", "v1", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.v1+"_"+tt.v2, func(t *testing.T) {
+			got := CompareVersionPriority(tt.v1, tt.v2)
+			if (got > 0) != (tt.want > 0) || (got < 0) != (tt.want < 0) {
+				t.Errorf("CompareVersionPriority() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortGroupVersions(t *testing.T) {
+	gvs := []GroupVersion{
+		{Group: "b", Version: "v1beta1"},
+		{Group: "a", Version: "v1alpha1"},
+		{Group: "b", Version: "v1"},
+		{Group: "a", Version: "v2"},
+	}
+	want := []GroupVersion{
+		{Group: "b", Version: "v1"},
+		{Group: "b", Version: "v1beta1"},
+		{Group: "a", Version: "v2"},
+		{Group: "a", Version: "v1alpha1"},
+	}
+
+	SortGroupVersions(gvs)
+	if !reflect.DeepEqual(gvs, want) {
+		t.Errorf("SortGroupVersions() = %v, want %v", gvs, want)
+	}
+}
@@ -0,0 +1,590 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/storage/dao"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// ResourceVersionColumn is the column of resourceVersion when metav1.EntityMeta is inlined
+	ResourceVersionColumn = "resource_version"
+	// CreationTimestampColumn is the column of creationTimestamp when metav1.EntityMeta is inlined
+	CreationTimestampColumn = "creation_timestamp"
+	// UpdateTimestampColumn is the column of updateTimestamp when metav1.EntityMeta is inlined
+	UpdateTimestampColumn = "update_timestamp"
+	// DeletionTimestampColumn is the column of deletionTimestamp when metav1.EntityMeta is inlined
+	DeletionTimestampColumn = "deletion_timestamp"
+	// SoftDeletionColumn is the column written by the soft deletion of Storage
+	SoftDeletionColumn = "inner_deletion_timestamp"
+
+	resourceVersionCallback = "apimachinery:resource_version"
+	resourceVersionConflict = "apimachinery:resource_version_conflict"
+	timestampCallback       = "apimachinery:timestamp"
+	assignmentCallback      = "apimachinery:assignment"
+)
+
+// CallbackOption configures the callbacks registered by RegisterCallbacks
+type CallbackOption func(c *callbackConfig)
+
+// WithClock sets the Clock of timestamps and resourceVersions, RealClock is used by default.
+// The Clock can be replaced for a statement by ContextWithClock.
+func WithClock(clock Clock) CallbackOption {
+	return func(c *callbackConfig) {
+		c.clock = clock
+	}
+}
+
+// RegisterCallbacks registers the callbacks of storage into gorm.DB.
+//
+// The resourceVersion of every metav1.Meta written by gorm.DB, which is stored as JSON column or
+// inlined as ResourceVersionColumn, is set on create and bumped on every update. The update of a
+// non-empty resourceVersion compares and swaps it, ErrConflict is returned when no row matches
+// (the object is stale), so concurrent writers cannot lose each other's changes.
+//
+// The timestamps of metav1.Meta (or the inlined CreationTimestampColumn, UpdateTimestampColumn and
+// DeletionTimestampColumn) are maintained by the Clock:
+//   - the creationTimestamp and updateTimestamp are set on create.
+//   - the updateTimestamp is refreshed on every update, the stored creationTimestamp is kept.
+//   - the deletionTimestamp is set when the object is marked for deletion by Delete, soft deleted
+//     (SoftDeletionColumn is written) or deleted. The metadata absent from the written values is
+//     stamped as well if the schema of statement is known, e.g. by gorm.DB.Model.
+//
+// The stored creationTimestamp of JSON column is kept by JSON_SET of mysql and jsonb_set of postgres,
+// it's overwritten by other dialects.
+//
+// The callbacks are registered by GenericStorageFactory.AddKnownStorages if absent, RegisterCallbacks
+// is called before it to configure the callbacks.
+func RegisterCallbacks(tx *gorm.DB, opts ...CallbackOption) error {
+	c := &callbackConfig{clock: RealClock, versions: &versionClock{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if err := tx.Callback().Create().Before("gorm:create").Register(timestampCallback, c.initTimestamps); err != nil {
+		return err
+	}
+	if err := tx.Callback().Update().Before("gorm:update").Register(timestampCallback, c.refreshTimestamps); err != nil {
+		return err
+	}
+	if err := tx.Callback().Delete().Before("gorm:delete").Register(timestampCallback, c.deletionTimestamps); err != nil {
+		return err
+	}
+	if err := tx.Callback().Create().Before("gorm:create").Register(resourceVersionCallback, c.initResourceVersion); err != nil {
+		return err
+	}
+	if err := tx.Callback().Update().Before("gorm:update").After(timestampCallback).Register(resourceVersionCallback, c.swapResourceVersion); err != nil {
+		return err
+	}
+	if err := tx.Callback().Update().Before("gorm:update").After(resourceVersionCallback).Register(assignmentCallback, assignTimestamps); err != nil {
+		return err
+	}
+	return tx.Callback().Update().After("gorm:update").Register(resourceVersionConflict, checkResourceVersion)
+}
+
+// callbacksRegistered checks if the callbacks of RegisterCallbacks are registered into gorm.DB
+func callbacksRegistered(tx *gorm.DB) bool {
+	return tx.Callback().Update().Get(resourceVersionCallback) != nil
+}
+
+// callbackConfig holds the state of callbacks registered by RegisterCallbacks
+type callbackConfig struct {
+	clock    Clock
+	versions *versionClock
+}
+
+// now returns the time of the Clock in the context of statement, or the Clock of RegisterCallbacks
+func (c *callbackConfig) now(tx *gorm.DB) time.Time {
+	return clockFrom(tx.Statement.Context, c.clock).Now()
+}
+
+// versionClock generates the increasing resourceVersions
+type versionClock struct {
+	mu   sync.Mutex
+	last int64
+}
+
+func (c *versionClock) next(t time.Time) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := t.UnixNano()
+	if now <= c.last {
+		now = c.last + 1
+	}
+	c.last = now
+	return strconv.FormatInt(now, 10)
+}
+
+// resourceVersion is the resourceVersion of a row written by gorm.Statement
+type resourceVersion struct {
+	column string
+	// path is the JSON path of resourceVersion when column is a JSON column
+	path  []string
+	value string
+	set   func(rv string)
+}
+
+func (rv *resourceVersion) expression() clause.Expression {
+	if len(rv.path) == 0 {
+		return clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: rv.column}, Value: rv.value}
+	}
+	return dao.JSONQuery(rv.column).Equals(rv.value, rv.path...)
+}
+
+func (c *callbackConfig) initResourceVersion(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	now := c.now(tx)
+	for _, rv := range resourceVersionsOf(tx.Statement) {
+		rv.set(c.versions.next(now))
+	}
+}
+
+func (c *callbackConfig) swapResourceVersion(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	now := c.now(tx)
+
+	rvs := resourceVersionsOf(tx.Statement)
+	exprs := make([]clause.Expression, 0, len(rvs))
+	for _, rv := range rvs {
+		if rv.value != "" {
+			exprs = append(exprs, rv.expression())
+		}
+		rv.set(c.versions.next(now))
+	}
+	if len(exprs) != 0 {
+		tx.Statement.AddClause(clause.Where{Exprs: exprs})
+		tx.InstanceSet(resourceVersionCallback, rvs)
+	}
+}
+
+func checkResourceVersion(tx *gorm.DB) {
+	v, ok := tx.InstanceGet(resourceVersionCallback)
+	if !ok || tx.DryRun {
+		return
+	}
+
+	if tx.Error == nil && tx.RowsAffected == 0 {
+		_ = tx.AddError(ErrConflict)
+	}
+	if tx.Error != nil {
+		// the object isn't written, reverts resourceVersions
+		for _, rv := range v.([]*resourceVersion) {
+			rv.set(rv.value)
+		}
+	}
+}
+
+// resourceVersionsOf extracts the resourceVersions from the destination of gorm.Statement
+func resourceVersionsOf(stmt *gorm.Statement) []*resourceVersion {
+	rvs := make([]*resourceVersion, 0)
+
+	if values, ok := stmt.Dest.(map[string]interface{}); ok {
+		for column, value := range values {
+			column := column
+			if rv, ok := value.(string); ok && column == ResourceVersionColumn {
+				rvs = append(rvs, &resourceVersion{
+					column: column,
+					value:  rv,
+					set:    func(rv string) { values[column] = rv },
+				})
+				continue
+			}
+			if rv := metaResourceVersion(column, reflect.ValueOf(value), func(v any) { values[column] = v }); rv != nil {
+				rvs = append(rvs, rv)
+			}
+		}
+		return rvs
+	}
+
+	if stmt.Schema == nil {
+		return rvs
+	}
+
+	rv := reflect.Indirect(stmt.ReflectValue)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			rvs = append(rvs, structResourceVersions(stmt, reflect.Indirect(rv.Index(i)))...)
+		}
+	case reflect.Struct:
+		rvs = append(rvs, structResourceVersions(stmt, rv)...)
+	}
+
+	return rvs
+}
+
+func structResourceVersions(stmt *gorm.Statement, rv reflect.Value) []*resourceVersion {
+	rvs := make([]*resourceVersion, 0)
+	if !rv.IsValid() || !rv.CanAddr() {
+		return rvs
+	}
+
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		fv := field.ReflectValueOf(stmt.Context, rv)
+		if field.DBName == ResourceVersionColumn && fv.Kind() == reflect.String {
+			rvs = append(rvs, &resourceVersion{
+				column: field.DBName,
+				value:  fv.String(),
+				set:    func(rv string) { fv.SetString(rv) },
+			})
+			continue
+		}
+		if meta := metaResourceVersion(field.DBName, fv.Addr(), nil); meta != nil {
+			rvs = append(rvs, meta)
+		}
+	}
+	return rvs
+}
+
+// metaResourceVersion returns the resourceVersion of the metav1.Meta stored as JSON column,
+// the copy of non-pointer value is committed by commit.
+func metaResourceVersion(column string, value reflect.Value, commit func(any)) *resourceVersion {
+	meta, done := metaOf(value, commit)
+	if meta == nil {
+		return nil
+	}
+
+	return &resourceVersion{
+		column: column,
+		path:   []string{"resourceVersion"},
+		value:  meta.GetResourceVersion(),
+		set: func(rv string) {
+			meta.SetResourceVersion(rv)
+			done()
+		},
+	}
+}
+
+// metaOf returns the metav1.Meta stored as JSON column and the func committing its changes,
+// the copy of non-pointer value is committed by commit.
+func metaOf(value reflect.Value, commit func(any)) (metav1.Meta, func()) {
+	var meta metav1.Meta
+	switch {
+	case !value.IsValid():
+		return nil, nil
+	case value.Kind() == reflect.Ptr:
+		if value.IsNil() {
+			return nil, nil
+		}
+		if value.Elem().Kind() == reflect.Ptr {
+			return metaOf(value.Elem(), nil)
+		}
+		meta, _ = value.Interface().(metav1.Meta)
+		commit = nil
+	case value.Kind() == reflect.Struct && commit != nil:
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		meta, _ = ptr.Interface().(metav1.Meta)
+		value = ptr
+	}
+	if meta == nil {
+		return nil, nil
+	}
+
+	return meta, func() {
+		if commit != nil {
+			commit(value.Elem().Interface())
+		}
+	}
+}
+
+// timestamps sets the timestamp of a row written by gorm.Statement, the column is
+// CreationTimestampColumn, UpdateTimestampColumn or DeletionTimestampColumn. The
+// deletionTimestamp is only set once.
+type timestamps func(column string, t int64)
+
+func (c *callbackConfig) initTimestamps(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	now := c.now(tx).Unix()
+	for _, set := range timestampsOf(tx.Statement) {
+		set(CreationTimestampColumn, now)
+		set(UpdateTimestampColumn, now)
+	}
+}
+
+func (c *callbackConfig) refreshTimestamps(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	now := c.now(tx).Unix()
+	deleting := deletionRequested(tx.Statement.Context) || softDeleting(tx.Statement)
+	for _, set := range timestampsOf(tx.Statement) {
+		set(UpdateTimestampColumn, now)
+		if deleting {
+			set(DeletionTimestampColumn, now)
+		}
+	}
+	if deleting {
+		// the metadata absent from the values is stamped by assignTimestamps
+		tx.InstanceSet(timestampCallback, now)
+	}
+}
+
+func (c *callbackConfig) deletionTimestamps(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	now := c.now(tx).Unix()
+	for _, set := range timestampsOf(tx.Statement) {
+		set(DeletionTimestampColumn, now)
+	}
+}
+
+// softDeleting checks if the statement soft deletes rows by SoftDeletionColumn
+func softDeleting(stmt *gorm.Statement) bool {
+	values, ok := stmt.Dest.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	v, ok := values[SoftDeletionColumn]
+	return ok && v != nil && !reflect.ValueOf(v).IsZero()
+}
+
+// timestampsOf extracts the timestamps from the destination of gorm.Statement. The values of map
+// are regarded as an inlined metav1.EntityMeta when any column of metadata is written.
+func timestampsOf(stmt *gorm.Statement) []timestamps {
+	out := make([]timestamps, 0)
+
+	if values, ok := stmt.Dest.(map[string]interface{}); ok {
+		inlined := false
+		for column, value := range values {
+			column := column
+			switch column {
+			case ResourceVersionColumn, CreationTimestampColumn, UpdateTimestampColumn, DeletionTimestampColumn:
+				inlined = true
+				continue
+			}
+			if meta, done := metaOf(reflect.ValueOf(value), func(v any) { values[column] = v }); meta != nil {
+				out = append(out, metaTimestamps(meta, done))
+			}
+		}
+		if inlined {
+			out = append(out, func(column string, t int64) {
+				if column == DeletionTimestampColumn {
+					if v, ok := values[column]; ok && v != nil && !reflect.ValueOf(v).IsZero() {
+						return
+					}
+				}
+				values[column] = t
+			})
+		}
+		return out
+	}
+
+	if stmt.Schema == nil {
+		return out
+	}
+
+	rv := reflect.Indirect(stmt.ReflectValue)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			out = append(out, structTimestamps(stmt, reflect.Indirect(rv.Index(i)))...)
+		}
+	case reflect.Struct:
+		out = append(out, structTimestamps(stmt, rv)...)
+	}
+
+	return out
+}
+
+func structTimestamps(stmt *gorm.Statement, rv reflect.Value) []timestamps {
+	out := make([]timestamps, 0)
+	if !rv.IsValid() || !rv.CanAddr() {
+		return out
+	}
+
+	columns := map[string]reflect.Value{}
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		fv := field.ReflectValueOf(stmt.Context, rv)
+		switch field.DBName {
+		case CreationTimestampColumn, UpdateTimestampColumn, DeletionTimestampColumn:
+			if fv.Kind() == reflect.Int64 {
+				columns[field.DBName] = fv
+			}
+			continue
+		}
+		if meta, done := metaOf(fv.Addr(), nil); meta != nil {
+			out = append(out, metaTimestamps(meta, done))
+		}
+	}
+	if len(columns) != 0 {
+		out = append(out, func(column string, t int64) {
+			fv, ok := columns[column]
+			if !ok || column == DeletionTimestampColumn && fv.Int() != 0 {
+				return
+			}
+			fv.SetInt(t)
+		})
+	}
+	return out
+}
+
+func metaTimestamps(meta metav1.Meta, done func()) timestamps {
+	return func(column string, t int64) {
+		switch column {
+		case CreationTimestampColumn:
+			meta.SetCreationTimestamp(t)
+		case UpdateTimestampColumn:
+			meta.SetUpdateTimestamp(t)
+		case DeletionTimestampColumn:
+			if meta.GetDeletionTimestamp() != 0 {
+				return
+			}
+			meta.SetDeletionTimestamp(t)
+		}
+		done()
+	}
+}
+
+// assignTimestamps builds the assignments of update in place of gorm:update. The inlined
+// CreationTimestampColumn is omitted and the stored creationTimestamp of JSON column is kept.
+// The metadata absent from the assignments is stamped by the deletionTimestamp of refreshTimestamps
+// when the schema of statement is known.
+func assignTimestamps(tx *gorm.DB) {
+	stmt := tx.Statement
+	if tx.Error != nil || stmt.SQL.Len() != 0 {
+		return
+	}
+	if _, ok := stmt.Clauses["SET"]; ok {
+		return
+	}
+	if _, ok := stmt.Dest.(map[string]interface{}); !ok && stmt.Schema == nil {
+		return
+	}
+
+	set := callbacks.ConvertToAssignments(stmt)
+	if len(set) == 0 {
+		return
+	}
+
+	assigned := map[string]bool{}
+	out := make(clause.Set, 0, len(set))
+	for _, assignment := range set {
+		assigned[assignment.Column.Name] = true
+		if assignment.Column.Name == CreationTimestampColumn {
+			continue
+		}
+		if value, ok := metaValue(assignment.Value); ok {
+			assignment.Value = keepJSONKey(stmt, assignment.Column.Name, value, "creationTimestamp")
+		}
+		out = append(out, assignment)
+	}
+
+	if now, ok := tx.InstanceGet(timestampCallback); ok && stmt.Schema != nil {
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" || assigned[field.DBName] {
+				continue
+			}
+			if field.DBName == DeletionTimestampColumn && field.FieldType.Kind() == reflect.Int64 {
+				out = append(out, clause.Assignment{Column: clause.Column{Name: field.DBName}, Value: now})
+				continue
+			}
+			if isMetaType(field.FieldType) {
+				if expr := setJSONKey(stmt, field.DBName, "deletionTimestamp", now); expr != nil {
+					out = append(out, clause.Assignment{Column: clause.Column{Name: field.DBName}, Value: expr})
+				}
+			}
+		}
+	}
+
+	stmt.AddClause(out)
+}
+
+var metaType = reflect.TypeOf((*metav1.Meta)(nil)).Elem()
+
+// isMetaType checks if the type (or its pointer) implements metav1.Meta
+func isMetaType(t reflect.Type) bool {
+	return t.Implements(metaType) || t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(metaType)
+}
+
+// metaValue returns the metav1.Meta stored as JSON column by the value of assignment,
+// the non-pointer value is copied to implement driver.Valuer.
+func metaValue(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+	rv := reflect.ValueOf(value)
+	if !isMetaType(rv.Type()) {
+		return nil, false
+	}
+	if rv.Kind() == reflect.Ptr {
+		return value, !rv.IsNil()
+	}
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+	return ptr.Interface(), true
+}
+
+// keepJSONKey returns the expression writing the JSON value into column with the stored value of key,
+// the value is written as is when the dialect is unknown.
+func keepJSONKey(stmt *gorm.Statement, column string, value interface{}, key string) interface{} {
+	switch stmt.Dialector.Name() {
+	case "mysql":
+		return clause.Expr{
+			SQL:  fmt.Sprintf("JSON_SET(?,'$.%s',COALESCE(JSON_EXTRACT(%s,'$.%s'),0))", key, stmt.Quote(column), key),
+			Vars: []interface{}{value},
+		}
+	case "postgres":
+		return clause.Expr{
+			SQL:  fmt.Sprintf("jsonb_set(CAST(? AS jsonb),'{%s}',COALESCE(%s::jsonb->'%s','0'))", key, stmt.Quote(column), key),
+			Vars: []interface{}{value},
+		}
+	}
+	return value
+}
+
+// setJSONKey returns the expression setting the key of the JSON stored in column, nil is returned
+// when the dialect is unknown.
+func setJSONKey(stmt *gorm.Statement, column, key string, value interface{}) clause.Expression {
+	switch stmt.Dialector.Name() {
+	case "mysql":
+		return clause.Expr{SQL: fmt.Sprintf("JSON_SET(%s,'$.%s',?)", stmt.Quote(column), key), Vars: []interface{}{value}}
+	case "postgres":
+		return clause.Expr{SQL: fmt.Sprintf("jsonb_set(%s::jsonb,'{%s}',to_jsonb(CAST(? AS bigint)))", stmt.Quote(column), key), Vars: []interface{}{value}}
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// execPool records the statements executed by gorm.DB
+type execPool struct {
+	sqls []string
+	vars [][]interface{}
+	rows int64
+}
+
+func (p *execPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("unsupported")
+}
+
+func (p *execPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.sqls = append(p.sqls, query)
+	p.vars = append(p.vars, args)
+	return driver.RowsAffected(p.rows), nil
+}
+
+func (p *execPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("unsupported")
+}
+
+func (p *execPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+type execDialector struct {
+	name string
+	pool *execPool
+}
+
+func (d execDialector) Name() string { return d.name }
+
+func (d execDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	db.ConnPool = d.pool
+	return nil
+}
+
+func (d execDialector) Migrator(db *gorm.DB) gorm.Migrator { return nil }
+
+func (d execDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (d execDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (d execDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	writer.WriteByte('?')
+}
+
+func (d execDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteString(`"` + str + `"`)
+}
+
+func (d execDialector) Explain(sql string, vars ...interface{}) string { return sql }
+
+type rvRow struct {
+	Uid        string             `gorm:"primaryKey"`
+	ObjectMeta *metav1.ObjectMeta `gorm:"column:object_meta"`
+}
+
+func (rvRow) TableName() string { return "rv_rows" }
+
+func openExecDB(t *testing.T, opts ...CallbackOption) (*gorm.DB, *execPool) {
+	return openDialectDB(t, "sqlite", opts...)
+}
+
+// openDialectDB opens gorm.DB with the statements of the named dialect
+func openDialectDB(t *testing.T, name string, opts ...CallbackOption) (*gorm.DB, *execPool) {
+	pool := &execPool{rows: 1}
+	db, err := gorm.Open(execDialector{name: name, pool: pool}, &gorm.Config{SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = RegisterCallbacks(db, opts...); err != nil {
+		t.Fatal(err)
+	}
+	return db, pool
+}
+
+func TestResourceVersionCreate(t *testing.T) {
+	db, _ := openExecDB(t)
+
+	row := &rvRow{Uid: "1", ObjectMeta: &metav1.ObjectMeta{Uid: "1", ResourceVersion: "client"}}
+	if err := db.Create(row).Error; err != nil {
+		t.Fatal(err)
+	}
+	if rv := row.ObjectMeta.ResourceVersion; rv == "" || rv == "client" {
+		t.Fatalf("resourceVersion = %q, expected to be generated", rv)
+	}
+}
+
+func TestResourceVersionUpdate(t *testing.T) {
+	db, pool := openExecDB(t)
+
+	meta := &metav1.ObjectMeta{Uid: "1", ResourceVersion: "5"}
+	err := db.Table("rv_rows").Where("uid = ?", "1").Updates(map[string]interface{}{"object_meta": meta}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql := pool.sqls[len(pool.sqls)-1]
+	if !strings.Contains(sql, `JSON_EXTRACT("object_meta",?) = ?`) {
+		t.Fatalf("SQL %s does not compare resourceVersion", sql)
+	}
+	vars := pool.vars[len(pool.vars)-1]
+	if vars[len(vars)-1] != "5" {
+		t.Fatalf("unexpected vars %v", vars)
+	}
+	if meta.ResourceVersion == "5" || meta.ResourceVersion == "" {
+		t.Fatalf("resourceVersion = %q, expected to be bumped", meta.ResourceVersion)
+	}
+
+	// stale object
+	pool.rows = 0
+	meta.ResourceVersion = "5"
+	err = db.Table("rv_rows").Where("uid = ?", "1").Updates(map[string]interface{}{"object_meta": meta}).Error
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if meta.ResourceVersion != "5" {
+		t.Fatalf("resourceVersion = %q, expected to be reverted", meta.ResourceVersion)
+	}
+
+	// unconditional update
+	meta.ResourceVersion = ""
+	if err = db.Table("rv_rows").Where("uid = ?", "1").Updates(map[string]interface{}{"object_meta": meta}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if sql = pool.sqls[len(pool.sqls)-1]; strings.Contains(sql, `JSON_EXTRACT("object_meta",?) = ?`) {
+		t.Fatalf("SQL %s of unconditional update compares resourceVersion", sql)
+	}
+	if meta.ResourceVersion == "" {
+		t.Fatal("resourceVersion is not bumped")
+	}
+}
+
+func TestResourceVersionColumn(t *testing.T) {
+	db, pool := openExecDB(t)
+
+	values := map[string]interface{}{ResourceVersionColumn: "3", "name": "a"}
+	if err := db.Table("entities").Where("uid = ?", 1).Updates(values).Error; err != nil {
+		t.Fatal(err)
+	}
+	sql := pool.sqls[len(pool.sqls)-1]
+	if !strings.Contains(sql, `"entities"."resource_version" = ?`) {
+		t.Fatalf("SQL %s does not compare resourceVersion", sql)
+	}
+	if rv := values[ResourceVersionColumn]; rv == "3" {
+		t.Fatalf("resourceVersion = %v, expected to be bumped", rv)
+	}
+}
+
+type tsEntity struct {
+	metav1.EntityMeta
+}
+
+func (tsEntity) TableName() string { return "ts_entities" }
+
+func TestTimestamps(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	db, pool := openDialectDB(t, "mysql", WithClock(ClockFunc(func() time.Time { return now })))
+	lastSQL := func() string { return pool.sqls[len(pool.sqls)-1] }
+
+	row := &rvRow{Uid: "1", ObjectMeta: &metav1.ObjectMeta{Uid: "1", CreationTimestamp: 1}}
+	if err := db.Create(row).Error; err != nil {
+		t.Fatal(err)
+	}
+	if row.ObjectMeta.CreationTimestamp != now.Unix() || row.ObjectMeta.UpdateTimestamp != now.Unix() {
+		t.Fatalf("timestamps of JSON column = %d, %d", row.ObjectMeta.CreationTimestamp, row.ObjectMeta.UpdateTimestamp)
+	}
+
+	entity := &tsEntity{EntityMeta: metav1.EntityMeta{Uid: 1}}
+	if err := db.Create(entity).Error; err != nil {
+		t.Fatal(err)
+	}
+	if entity.CreationTimestamp != now.Unix() || entity.UpdateTimestamp != now.Unix() {
+		t.Fatalf("timestamps of inlined columns = %d, %d", entity.CreationTimestamp, entity.UpdateTimestamp)
+	}
+
+	// the Clock of context
+	fixed := time.Unix(1600000000, 0)
+	ctx := ContextWithClock(context.TODO(), ClockFunc(func() time.Time { return fixed }))
+	if err := db.WithContext(ctx).Create(&tsEntity{EntityMeta: metav1.EntityMeta{Uid: 2}}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if vars := pool.vars[len(pool.vars)-1]; !containsVar(vars, fixed.Unix()) {
+		t.Fatalf("timestamps of context Clock are not written: %v", vars)
+	}
+
+	now = now.Add(time.Minute)
+
+	// the stored creationTimestamp of JSON column is kept
+	meta := &metav1.ObjectMeta{Uid: "1", CreationTimestamp: 5}
+	if err := db.Table("rv_rows").Where("uid = ?", "1").Updates(map[string]interface{}{"object_meta": meta}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if meta.UpdateTimestamp != now.Unix() {
+		t.Fatalf("updateTimestamp of JSON column = %d", meta.UpdateTimestamp)
+	}
+	if want := `"object_meta"=JSON_SET(?,'$.creationTimestamp',COALESCE(JSON_EXTRACT("object_meta",'$.creationTimestamp'),0))`; !strings.Contains(lastSQL(), want) {
+		t.Fatalf("SQL %s\ndoes not contain %s", lastSQL(), want)
+	}
+
+	// the inlined creationTimestamp isn't written
+	values := map[string]interface{}{ResourceVersionColumn: "", CreationTimestampColumn: int64(5), "name": "a"}
+	if err := db.Table("ts_entities").Where("uid = ?", 1).Updates(values).Error; err != nil {
+		t.Fatal(err)
+	}
+	if values[UpdateTimestampColumn] != now.Unix() {
+		t.Fatalf("timestamps of inlined columns = %v", values)
+	}
+	if strings.Contains(lastSQL(), CreationTimestampColumn) {
+		t.Fatalf("SQL %s writes creationTimestamp", lastSQL())
+	}
+
+	// the values without metadata
+	values = map[string]interface{}{"name": "a"}
+	if err := db.Table("ts_entities").Where("uid = ?", 1).Updates(values).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := values[UpdateTimestampColumn]; ok {
+		t.Fatalf("updateTimestamp is set on the values without metadata: %v", values)
+	}
+
+	now = now.Add(time.Minute)
+	entity.Name = "b"
+	entity.CreationTimestamp = 5
+	if err := db.Model(entity).Updates(entity).Error; err != nil {
+		t.Fatal(err)
+	}
+	if entity.UpdateTimestamp != now.Unix() {
+		t.Fatalf("updateTimestamp = %d, want %d", entity.UpdateTimestamp, now.Unix())
+	}
+	if sql := lastSQL(); !strings.Contains(sql, `"update_timestamp"=?`) || strings.Contains(sql, CreationTimestampColumn) || strings.Contains(sql, DeletionTimestampColumn) {
+		t.Fatalf("SQL %s of update", sql)
+	}
+
+	// marked for deletion
+	if err := db.WithContext(withDeletion(context.TODO())).Model(entity).Updates(entity).Error; err != nil {
+		t.Fatal(err)
+	}
+	if entity.DeletionTimestamp != now.Unix() || !strings.Contains(lastSQL(), `"deletion_timestamp"=?`) {
+		t.Fatalf("deletionTimestamp = %d, SQL %s", entity.DeletionTimestamp, lastSQL())
+	}
+
+	// the deletionTimestamp is set once
+	now = now.Add(time.Minute)
+	if err := db.WithContext(withDeletion(context.TODO())).Model(entity).Updates(entity).Error; err != nil {
+		t.Fatal(err)
+	}
+	if entity.DeletionTimestamp != now.Add(-time.Minute).Unix() {
+		t.Fatalf("deletionTimestamp = %d is overwritten", entity.DeletionTimestamp)
+	}
+
+	// soft deleted, the JSON column absent from values is stamped
+	err := db.Model(&rvRow{}).Where("uid = ?", "1").Updates(map[string]interface{}{SoftDeletionColumn: now.UnixNano()}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"object_meta"=JSON_SET("object_meta",'$.deletionTimestamp',?)`; !strings.Contains(lastSQL(), want) {
+		t.Fatalf("SQL %s\ndoes not contain %s", lastSQL(), want)
+	}
+
+	// deleted
+	deleted := &tsEntity{EntityMeta: metav1.EntityMeta{Uid: 3}}
+	if err = db.Where("uid = ?", 3).Delete(deleted).Error; err != nil {
+		t.Fatal(err)
+	}
+	if deleted.DeletionTimestamp != now.Unix() {
+		t.Fatalf("deletionTimestamp = %d, want %d", deleted.DeletionTimestamp, now.Unix())
+	}
+}
+
+func containsVar(vars []interface{}, v interface{}) bool {
+	for _, item := range vars {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFactoryRegistersCallbacks(t *testing.T) {
+	pool := &execPool{rows: 1}
+	db, err := gorm.Open(execDialector{name: "sqlite", pool: pool}, &gorm.Config{SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := NewStorageFactory()
+	if err = f.AddKnownStorages(db, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	if !callbacksRegistered(db) {
+		t.Fatal("callbacks are not registered by AddKnownStorages")
+	}
+	// registered once per gorm.DB
+	if err = f.AddKnownStorages(db, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+
+	row := &rvRow{Uid: "1", ObjectMeta: &metav1.ObjectMeta{Uid: "1"}}
+	if err = db.Create(row).Error; err != nil {
+		t.Fatal(err)
+	}
+	if row.ObjectMeta.ResourceVersion == "" || row.ObjectMeta.CreationTimestamp == 0 {
+		t.Fatalf("metadata is not maintained by callbacks: %v", row.ObjectMeta)
+	}
+}
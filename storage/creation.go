@@ -0,0 +1,143 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vine-io/apimachinery/apis/meta"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema/fields"
+	"gorm.io/gorm"
+)
+
+// uniqueViolationMessages are the fragments of unique constraint violation messages
+// reported by the drivers of sqlite, mysql and postgres.
+var uniqueViolationMessages = []string{
+	"unique constraint",
+	"duplicate entry",
+	"duplicate key",
+}
+
+// IsUniqueViolation checks whether err is raised by a unique constraint violation,
+// both gorm.ErrDuplicatedKey (gorm.Config.TranslateError) and the raw driver errors are recognized.
+func IsUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrAlreadyExists) || errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range uniqueViolationMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Create creates the object by its Storage. When the Name of object is empty, it is generated from
+// GenerateName with a random suffix, and regenerated on the collision with the objects in the same
+// namespace, ErrAlreadyExists is returned after MaxNameGenerationAttempts collisions.
+// The Uid is assigned by the uid.Generator of Factory if it's empty, and the Generation of created
+// object starts at 1. The given object is left unchanged, the created object is returned.
+func Create(ctx context.Context, f Factory, tx *gorm.DB, in runtime.Object) (runtime.Object, error) {
+	in = in.DeepCopyObject()
+	m, err := meta.Accessor(in)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	m.SetGeneration(1)
+	if err = assignUID(f, in, m); err != nil {
+		return nil, err
+	}
+	if m.GetName() != "" || m.GetGenerateName() == "" {
+		s, err := f.NewStorage(tx, in)
+		if err != nil {
+			return nil, err
+		}
+		return s.Create(ctx)
+	}
+
+	for i := 0; i < MaxNameGenerationAttempts; i++ {
+		obj := in.DeepCopyObject()
+		om, _ := meta.Accessor(obj)
+		GenerateName(om)
+
+		exists, err := nameExists(ctx, f, tx, obj, om.GetNamespace(), om.GetName())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		s, err := f.NewStorage(tx, obj)
+		if err != nil {
+			return nil, err
+		}
+		out, err := s.Create(ctx)
+		if err == nil {
+			return out, nil
+		}
+		if !IsUniqueViolation(err) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unable to generate a unique name from %q", ErrAlreadyExists, m.GetGenerateName())
+}
+
+// nameExists checks whether the name is taken in the namespace. The collision can't be detected
+// before creation if the Storage has no field mappings of metadata.name and metadata.namespace,
+// false is returned in this case and the unique constraint of storage is relied on.
+func nameExists(ctx context.Context, f Factory, tx *gorm.DB, in runtime.Object, namespace, name string) (bool, error) {
+	gvk := in.GetObjectKind().GroupVersionKind()
+	selector := fields.SelectorFromSet(fields.Set{"metadata.namespace": namespace, "metadata.name": name})
+	exprs, err := f.FieldExpressions(gvk, selector)
+	if errors.Is(err, fields.ErrFieldNotSelectable) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// queries by an empty object, the fields of object are not taken as conditions
+	probe, err := f.NewObject(gvk)
+	if err != nil {
+		return false, err
+	}
+	s, err := f.NewStorage(tx, probe)
+	if err != nil {
+		return false, err
+	}
+	total, err := s.Cond(exprs...).Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	return total > 0, nil
+}
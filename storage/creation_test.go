@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+// seqNameGenerator generates the names in sequence
+type seqNameGenerator struct {
+	suffixes []string
+}
+
+func (g *seqNameGenerator) GenerateName(base string) string {
+	suffix := g.suffixes[0]
+	g.suffixes = g.suffixes[1:]
+	return base + suffix
+}
+
+func TestSimpleNameGenerator(t *testing.T) {
+	name := SimpleNameGenerator.GenerateName("foo-")
+	if !strings.HasPrefix(name, "foo-") || len(name) != len("foo-")+randomLength {
+		t.Fatalf("GenerateName() = %s", name)
+	}
+	if name == SimpleNameGenerator.GenerateName("foo-") {
+		t.Fatalf("GenerateName() should be random")
+	}
+
+	name = SimpleNameGenerator.GenerateName(strings.Repeat("a", 100))
+	if len(name) != maxNameLength {
+		t.Fatalf("the length of name = %d, want %d", len(name), maxNameLength)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.TODO()
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	memRows = map[string]*MemObj{}
+	defer func(g NameGenerator) { DefaultNameGenerator = g }(DefaultNameGenerator)
+
+	newObj := func(uid, name, generateName string) *MemObj {
+		o := &MemObj{ObjectMeta: metav1.ObjectMeta{Uid: uid, Name: name, GenerateName: generateName, Namespace: "default"}}
+		o.SetGroupVersionKind(SchemeGroupVersion.WithKind("MemObj"))
+		return o
+	}
+
+	// the name is present
+	if _, err := Create(ctx, f, nil, newObj("1", "foo-a", "foo-")); err != nil {
+		t.Fatal(err)
+	}
+	if memRows["1"].Name != "foo-a" {
+		t.Fatalf("name = %s, want foo-a", memRows["1"].Name)
+	}
+
+	// the given object is left unchanged
+	in := newObj("", "", "bar-")
+	if _, err := Create(ctx, f, nil, in); err != nil {
+		t.Fatal(err)
+	}
+	if want := newObj("", "", "bar-"); !reflect.DeepEqual(in, want) {
+		t.Fatalf("object is mutated by Create: %v", in)
+	}
+
+	// the name collides with foo-a
+	DefaultNameGenerator = &seqNameGenerator{suffixes: []string{"a", "b"}}
+	out, err := Create(ctx, f, nil, newObj("2", "", "foo-"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := out.(metav1.Meta).GetName(); name != "foo-b" {
+		t.Fatalf("name = %s, want foo-b", name)
+	}
+
+	// gives up after MaxNameGenerationAttempts
+	suffixes := make([]string, MaxNameGenerationAttempts)
+	for i := range suffixes {
+		suffixes[i] = "a"
+	}
+	DefaultNameGenerator = &seqNameGenerator{suffixes: suffixes}
+	if _, err = Create(ctx, f, nil, newObj("3", "", "foo-")); !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if !IsUniqueViolation(err) {
+		t.Fatalf("IsUniqueViolation() = false")
+	}
+}
@@ -306,6 +306,8 @@ type JSONQueryExpression struct {
 	keys        []string
 	hasKeys     bool
 	contains    bool
+	element     bool
+	field       string
 	equals      bool
 	equalsValue interface{}
 	op          EOp
@@ -325,6 +327,12 @@ func (jsonQuery *JSONQueryExpression) Extract(path string) *JSONQueryExpression
 	return jsonQuery
 }
 
+// ExtractKeys extract json at keys, it's supported by postgres as well
+func (jsonQuery *JSONQueryExpression) ExtractKeys(keys ...string) *JSONQueryExpression {
+	jsonQuery.keys = keys
+	return jsonQuery.Extract(jsonQueryJoin(keys))
+}
+
 // HasKey returns clause.Expression
 func (jsonQuery *JSONQueryExpression) HasKey(keys ...string) *JSONQueryExpression {
 	jsonQuery.keys = keys
@@ -348,6 +356,17 @@ func (jsonQuery *JSONQueryExpression) Contains(tx *gorm.DB, values interface{},
 	return jsonQuery, query
 }
 
+// HasElement checks if the JSON array at keys holds an object whose field equals value,
+// e.g. HasElement("uid", uid, "references") matches the objects referring to the uid.
+// The array is queried in place, no join is required as Contains does.
+func (jsonQuery *JSONQueryExpression) HasElement(field string, value interface{}, keys ...string) *JSONQueryExpression {
+	jsonQuery.keys = keys
+	jsonQuery.element = true
+	jsonQuery.field = field
+	jsonQuery.equalsValue = value
+	return jsonQuery
+}
+
 // Equals Keys returns clause.Expression
 func (jsonQuery *JSONQueryExpression) Equals(value interface{}, keys ...string) *JSONQueryExpression {
 	jsonQuery.keys = keys
@@ -387,6 +406,17 @@ func (jsonQuery *JSONQueryExpression) Build(builder clause.Builder) {
 					builder.WriteString(fmt.Sprintf("JSON_CONTAINS(%s, %s)", stmt.Quote(jsonQuery.column), sm))
 				}
 
+			case jsonQuery.element:
+				builder.WriteString("JSON_CONTAINS(")
+				builder.WriteQuoted(jsonQuery.column)
+				builder.WriteByte(',')
+				builder.AddVar(stmt, jsonQuery.elementValue(false))
+				if len(jsonQuery.keys) > 0 {
+					builder.WriteByte(',')
+					builder.AddVar(stmt, jsonQueryJoin(jsonQuery.keys))
+				}
+				builder.WriteString(")")
+
 			case jsonQuery.extract:
 				builder.WriteString("JSON_EXTRACT(")
 				builder.WriteQuoted(jsonQuery.column)
@@ -448,6 +478,18 @@ func (jsonQuery *JSONQueryExpression) Build(builder clause.Builder) {
 					builder.WriteString(fmt.Sprintf("JSON_EXTRACT(JSON_EACH.value, '$.%s') %s ", strings.Join(jsonQuery.keys, "."), "="))
 				}
 				stmt.AddVar(builder, jsonQuery.equalsValue)
+			case jsonQuery.element:
+				builder.WriteString("EXISTS (SELECT 1 FROM JSON_EACH(")
+				builder.WriteQuoted(jsonQuery.column)
+				if len(jsonQuery.keys) > 0 {
+					builder.WriteByte(',')
+					builder.AddVar(stmt, jsonQueryJoin(jsonQuery.keys))
+				}
+				builder.WriteString(") WHERE JSON_EXTRACT(JSON_EACH.value,")
+				builder.AddVar(stmt, jsonQueryJoin([]string{jsonQuery.field}))
+				builder.WriteString(") = ")
+				builder.AddVar(stmt, jsonQuery.equalsValue)
+				builder.WriteString(")")
 			case jsonQuery.extract:
 				builder.WriteString("JSON_EXTRACT(")
 				builder.WriteQuoted(jsonQuery.column)
@@ -507,6 +549,22 @@ func (jsonQuery *JSONQueryExpression) Build(builder clause.Builder) {
 					builder.WriteString(fmt.Sprintf("%s %s ", pgJoin("o"+jsonQuery.column, jsonQuery.keys...), "="))
 				}
 				stmt.AddVar(builder, jsonQuery.equalsValue)
+			case jsonQuery.element:
+				stmt.WriteQuoted(jsonQuery.column)
+				stmt.WriteString("::jsonb")
+				for _, key := range jsonQuery.keys {
+					stmt.WriteString("->" + pgQuote(key))
+				}
+				stmt.WriteString(" @> ")
+				stmt.AddVar(builder, jsonQuery.elementValue(true))
+				stmt.WriteString("::jsonb")
+			case jsonQuery.extract:
+				builder.WriteString(fmt.Sprintf("json_extract_path_text(%v::json", stmt.Quote(jsonQuery.column)))
+				for _, key := range jsonQuery.keys {
+					builder.WriteByte(',')
+					stmt.AddVar(builder, key)
+				}
+				builder.WriteString(")")
 			case jsonQuery.hasKeys:
 				if len(jsonQuery.keys) > 0 {
 					stmt.WriteQuoted(jsonQuery.column)
@@ -563,33 +621,50 @@ func (jsonQuery *JSONQueryExpression) Build(builder clause.Builder) {
 	}
 }
 
-const prefix = "$."
-
-func jsonQueryJoin(keys []string) string {
-	if len(keys) == 1 {
-		return prefix + keys[0]
+// elementValue encodes the object matched by HasElement, it's wrapped by an array
+// when array is true, e.g. [{"uid":"1"}] for the containment of postgres.
+func (jsonQuery *JSONQueryExpression) elementValue(array bool) string {
+	var v interface{} = map[string]interface{}{jsonQuery.field: jsonQuery.equalsValue}
+	if array {
+		v = []interface{}{v}
 	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
 
-	n := len(prefix)
-	n += len(keys) - 1
-	for i := 0; i < len(keys); i++ {
-		n += len(keys[i])
-	}
+const prefix = "$."
 
+// jsonQueryJoin joins keys as JSON path of mysql and sqlite, the keys with
+// special characters are quoted, e.g. $.labels."app.io/name"
+func jsonQueryJoin(keys []string) string {
 	var b strings.Builder
-	b.Grow(n)
 	b.WriteString(prefix)
-	b.WriteString(keys[0])
-	for _, key := range keys[1:] {
-		b.WriteString(".")
-		b.WriteString(key)
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(quotePathKey(key))
 	}
 	return b.String()
 }
 
+func quotePathKey(key string) string {
+	if key == "" || key[0] >= '0' && key[0] <= '9' {
+		return strconv.Quote(key)
+	}
+	for _, c := range key {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return strconv.Quote(key)
+		}
+	}
+	return key
+}
+
+// pgJoin joins the keys into the path of json column, e.g. column->'a'->>'b'. The keys are
+// written as string literals, the quotes in them are escaped.
 func pgJoin(column string, keys ...string) string {
 	if len(keys) == 1 {
-		return column + "->>" + "'" + keys[0] + "'"
+		return column + "->>" + pgQuote(keys[0])
 	}
 	outs := []string{column}
 	for item, key := range keys {
@@ -598,7 +673,12 @@ func pgJoin(column string, keys ...string) string {
 		} else {
 			outs = append(outs, "->")
 		}
-		outs = append(outs, "'"+key+"'")
+		outs = append(outs, pgQuote(key))
 	}
 	return strings.Join(outs, "")
 }
+
+// pgQuote quotes s as the string literal of postgres
+func pgQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
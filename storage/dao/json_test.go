@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+func TestPgJoin(t *testing.T) {
+	tests := []struct {
+		keys []string
+		want string
+	}{
+		{keys: []string{"name"}, want: `col->>'name'`},
+		{keys: []string{"labels", "env"}, want: `col->'labels'->>'env'`},
+		{keys: []string{"labels", "a' OR '1'='1"}, want: `col->'labels'->>'a'' OR ''1''=''1'`},
+	}
+	for _, tt := range tests {
+		if got := pgJoin("col", tt.keys...); got != tt.want {
+			t.Errorf("pgJoin(%q) = %s, want %s", tt.keys, got, tt.want)
+		}
+	}
+}
+
+var numericPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// dryRunDialector renders SQL of the given dialect without a database
+type dryRunDialector struct {
+	name string
+}
+
+func (d dryRunDialector) Name() string { return d.name }
+
+func (d dryRunDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	return nil
+}
+
+func (d dryRunDialector) Migrator(db *gorm.DB) gorm.Migrator { return nil }
+
+func (d dryRunDialector) DataTypeOf(*schema.Field) string { return "" }
+
+func (d dryRunDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+
+func (d dryRunDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	if d.name == "postgres" {
+		writer.WriteString("$" + strconv.Itoa(len(stmt.Vars)))
+		return
+	}
+	writer.WriteByte('?')
+}
+
+func (d dryRunDialector) QuoteTo(writer clause.Writer, str string) {
+	writer.WriteString(`"` + str + `"`)
+}
+
+func (d dryRunDialector) Explain(sql string, vars ...interface{}) string {
+	if d.name == "postgres" {
+		return logger.ExplainSQL(sql, numericPlaceholder, `'`, vars...)
+	}
+	return logger.ExplainSQL(sql, nil, `'`, vars...)
+}
+
+func TestJSONQueryExpression_HasElement(t *testing.T) {
+	tests := []struct {
+		dialect string
+		keys    []string
+		want    string
+	}{
+		{
+			dialect: "mysql",
+			keys:    []string{"references"},
+			want:    `JSON_CONTAINS("object_meta",'{"uid":"a'' OR 1"}','$.references')`,
+		},
+		{
+			dialect: "mysql",
+			want:    `JSON_CONTAINS("object_meta",'{"uid":"a'' OR 1"}')`,
+		},
+		{
+			dialect: "sqlite",
+			keys:    []string{"references"},
+			want:    `EXISTS (SELECT 1 FROM JSON_EACH("object_meta",'$.references') WHERE JSON_EXTRACT(JSON_EACH.value,'$.uid') = 'a'' OR 1')`,
+		},
+		{
+			dialect: "sqlite",
+			want:    `EXISTS (SELECT 1 FROM JSON_EACH("object_meta") WHERE JSON_EXTRACT(JSON_EACH.value,'$.uid') = 'a'' OR 1')`,
+		},
+		{
+			dialect: "postgres",
+			keys:    []string{"references"},
+			want:    `"object_meta"::jsonb->'references' @> '[{"uid":"a'' OR 1"}]'::jsonb`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			db, err := gorm.Open(dryRunDialector{name: tt.dialect}, &gorm.Config{DryRun: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expr := JSONQuery("object_meta").HasElement("uid", "a' OR 1", tt.keys...)
+			stmt := db.Table("tests").Clauses(expr).Find(&[]map[string]interface{}{}).Statement
+			sql := db.Dialector.Explain(stmt.SQL.String(), stmt.Vars...)
+			if !strings.Contains(sql, tt.want) {
+				t.Errorf("SQL %s\ndoes not contain %s", sql, tt.want)
+			}
+		})
+	}
+}
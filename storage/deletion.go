@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vine-io/apimachinery/apis/meta"
+	"github.com/vine-io/apimachinery/runtime"
+	"gorm.io/gorm"
+)
+
+// Delete deletes the object by its Storage gracefully. The object holding finalizers is only
+// marked by DeletionTimestamp and returned, it is removed by Update once the last finalizer is
+// cleared. nil is returned when the object is removed immediately. The DeletionTimestamp is set by
+// the Clock of context, the callbacks of RegisterCallbacks stamp it again for the Storages backed by database.
+func Delete(ctx context.Context, f Factory, tx *gorm.DB, in runtime.Object, soft bool) (runtime.Object, error) {
+	s, current, err := loadCurrent(ctx, f, tx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, _ := meta.Accessor(current)
+	if len(cm.GetFinalizers()) == 0 {
+		return nil, s.Delete(ctx, soft)
+	}
+	if cm.GetDeletionTimestamp() != 0 {
+		// the deletion is in progress already
+		return current, nil
+	}
+
+	cm.SetDeletionTimestamp(clockFrom(ctx, RealClock).Now().Unix())
+	s, err = f.NewStorage(tx, current)
+	if err != nil {
+		return nil, err
+	}
+	return s.Updates(withDeletion(ctx))
+}
+
+type deletionKey struct{}
+
+// withDeletion returns the context of the update marking objects for deletion
+func withDeletion(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deletionKey{}, true)
+}
+
+// deletionRequested checks if the update of context marks objects for deletion
+func deletionRequested(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	marked, _ := ctx.Value(deletionKey{}).(bool)
+	return marked
+}
+
+// Update updates the object by its Storage. The DeletionTimestamp can't be reverted once it is set,
+// and the object marked for deletion is removed when its last finalizer is cleared, nil is returned
+// in this case. The Generation of the object is kept by storage and increased only when the fields
+// except metadata and status are changed. The CreationTimestamp is copied from the stored object.
+func Update(ctx context.Context, f Factory, tx *gorm.DB, in runtime.Object, soft bool) (runtime.Object, error) {
+	_, current, err := loadCurrent(ctx, f, tx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	in = in.DeepCopyObject()
+	m, err := meta.Accessor(in)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	cm, _ := meta.Accessor(current)
+	m.SetCreationTimestamp(cm.GetCreationTimestamp())
+	if ts := cm.GetDeletionTimestamp(); ts != 0 {
+		m.SetDeletionTimestamp(ts)
+	}
+	generation, err := nextGeneration(in, current)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	m.SetGeneration(generation)
+
+	s, err := f.NewStorage(tx, in)
+	if err != nil {
+		return nil, err
+	}
+	if m.GetDeletionTimestamp() != 0 && len(m.GetFinalizers()) == 0 {
+		return nil, s.Delete(ctx, soft)
+	}
+	return s.Updates(ctx)
+}
+
+// loadCurrent returns the Storage of the object and the object stored currently,
+// ErrConflict is returned when the resourceVersion of the object is stale.
+func loadCurrent(ctx context.Context, f Factory, tx *gorm.DB, in runtime.Object) (Storage, runtime.Object, error) {
+	m, err := meta.Accessor(in)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+
+	s, err := f.NewStorage(tx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := s.FindPk(ctx, m.GetUID())
+	if err != nil {
+		return nil, nil, err
+	}
+	cm, err := meta.Accessor(current)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	if rv := m.GetResourceVersion(); rv != "" && rv != cm.GetResourceVersion() {
+		return nil, nil, ErrConflict
+	}
+	current.GetObjectKind().SetGroupVersionKind(in.GetObjectKind().GroupVersionKind())
+
+	return s, current, nil
+}
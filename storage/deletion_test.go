@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MemObj struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Replicas int32        `json:"replicas,omitempty"`
+	Status   MemObjStatus `json:"status"`
+}
+
+type MemObjStatus struct {
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+func (m *MemObj) DeepCopyObject() runtime.Object {
+	out := new(MemObj)
+	*out = *m
+	m.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return out
+}
+
+func (m *MemObj) DeepFromObject(o runtime.Object) {
+	*m = *o.DeepCopyObject().(*MemObj)
+}
+
+type MemObjList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []*MemObj
+}
+
+func (m *MemObjList) DeepCopyObject() runtime.Object {
+	out := new(MemObjList)
+	*out = *m
+	out.Items = make([]*MemObj, len(m.Items))
+	for i := range m.Items {
+		out.Items[i] = m.Items[i].DeepCopyObject().(*MemObj)
+	}
+	return out
+}
+
+func (m *MemObjList) DeepFromObject(o runtime.Object) {
+	*m = *o.DeepCopyObject().(*MemObjList)
+}
+
+// memRows is the table of memStorage
+var memRows = map[string]*MemObj{}
+
+// memScans counts the calls of FindAll without conditions
+var memScans int
+
+// memStorage the in-memory Storage for MemObj
+type memStorage struct {
+	obj   *MemObj
+	exprs []clause.Expression
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (m *memStorage) Target() reflect.Type          { return reflect.TypeOf(new(MemObj)) }
+func (m *memStorage) AutoMigrate(tx *gorm.DB) error { return nil }
+
+func (m *memStorage) Cond(exprs ...clause.Expression) Storage {
+	m.exprs = append(m.exprs, exprs...)
+	return m
+}
+
+func (m *memStorage) Load(tx *gorm.DB, object runtime.Object) error {
+	m.obj = object.(*MemObj)
+	return nil
+}
+
+func (m *memStorage) FindPage(ctx context.Context, page, size int32) (runtime.Object, error) {
+	return nil, nil
+}
+
+func (m *memStorage) FindAll(ctx context.Context) (runtime.Object, error) {
+	uids := make([]string, 0, len(memRows))
+	for uid := range memRows {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+
+	// only clause.Limit is honoured
+	scan := true
+	for _, expr := range m.exprs {
+		if limit, ok := expr.(clause.Limit); ok {
+			uids = uids[min(limit.Offset, len(uids)):]
+			if limit.Limit != nil {
+				uids = uids[:min(*limit.Limit, len(uids))]
+			}
+			continue
+		}
+		scan = false
+	}
+	if scan {
+		memScans++
+	}
+
+	out := &MemObjList{}
+	for _, uid := range uids {
+		out.Items = append(out.Items, memRows[uid].DeepCopyObject().(*MemObj))
+	}
+	return out, nil
+}
+
+func (m *memStorage) Count(ctx context.Context) (int64, error) { return int64(len(memRows)), nil }
+
+func (m *memStorage) FindPk(ctx context.Context, pk any) (runtime.Object, error) {
+	row, ok := memRows[pk.(string)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return row.DeepCopyObject(), nil
+}
+
+func (m *memStorage) FindOne(ctx context.Context) (runtime.Object, error) {
+	return m.FindPk(ctx, m.obj.Uid)
+}
+
+func (m *memStorage) Create(ctx context.Context) (runtime.Object, error) {
+	for _, row := range memRows {
+		if row.Uid == m.obj.Uid || (row.Name != "" && row.Name == m.obj.Name && row.Namespace == m.obj.Namespace) {
+			return nil, gorm.ErrDuplicatedKey
+		}
+	}
+	memRows[m.obj.Uid] = m.obj.DeepCopyObject().(*MemObj)
+	return m.FindPk(ctx, m.obj.Uid)
+}
+
+func (m *memStorage) Updates(ctx context.Context) (runtime.Object, error) {
+	if _, ok := memRows[m.obj.Uid]; !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	memRows[m.obj.Uid] = m.obj.DeepCopyObject().(*MemObj)
+	return m.FindPk(ctx, m.obj.Uid)
+}
+
+func (m *memStorage) Delete(ctx context.Context, soft bool) error {
+	delete(memRows, m.obj.Uid)
+	return nil
+}
+
+func TestGracefulDelete(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ctx := ContextWithClock(context.TODO(), ClockFunc(func() time.Time { return now }))
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	memRows = map[string]*MemObj{}
+
+	newObj := func(uid string, finalizers ...string) *MemObj {
+		o := &MemObj{ObjectMeta: metav1.ObjectMeta{Uid: uid, CreationTimestamp: 10, Finalizers: finalizers}}
+		o.SetGroupVersionKind(SchemeGroupVersion.WithKind("MemObj"))
+		s, err := f.NewStorage(nil, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+		return o
+	}
+
+	// without finalizers
+	out, err := Delete(ctx, f, nil, newObj("1"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != nil || memRows["1"] != nil {
+		t.Fatalf("object without finalizers should be removed, got %v", out)
+	}
+
+	// with finalizers
+	o := newObj("2", "a", "b")
+	out, err = Delete(ctx, f, nil, o, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := out.(metav1.Meta).GetDeletionTimestamp()
+	if ts != now.Unix() || memRows["2"] == nil || memRows["2"].DeletionTimestamp != ts {
+		t.Fatalf("object with finalizers should be marked, got %v", memRows["2"])
+	}
+
+	// repeated deletion keeps the timestamp
+	if out, err = Delete(ctx, f, nil, o, true); err != nil || out.(metav1.Meta).GetDeletionTimestamp() != ts {
+		t.Fatalf("Delete() = %v, %v", out, err)
+	}
+
+	// DeletionTimestamp and CreationTimestamp can't be changed by Update
+	o.Finalizers = []string{"b"}
+	o.DeletionTimestamp = 0
+	o.CreationTimestamp = 20
+	if out, err = Update(ctx, f, nil, o, true); err != nil {
+		t.Fatal(err)
+	}
+	if out.(metav1.Meta).GetDeletionTimestamp() != ts || out.(metav1.Meta).GetCreationTimestamp() != 10 || !reflect.DeepEqual(out.(metav1.Meta).GetFinalizers(), []string{"b"}) {
+		t.Fatalf("Update() = %v", out)
+	}
+
+	// the last finalizer is cleared
+	o.Finalizers = nil
+	if out, err = Update(ctx, f, nil, o, true); err != nil {
+		t.Fatal(err)
+	}
+	if out != nil || memRows["2"] != nil {
+		t.Fatalf("object should be removed once finalizers are cleared, got %v", out)
+	}
+
+	// the stale object is rejected
+	o = newObj("4", "a")
+	memRows["4"].ResourceVersion = "2"
+	o.ResourceVersion = "1"
+	if _, err = Delete(ctx, f, nil, o, true); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if _, err = Update(ctx, f, nil, o, true); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+
+	// the object isn't marked for deletion
+	o = newObj("3", "a")
+	o.Finalizers = nil
+	if out, err = Update(ctx, f, nil, o, true); err != nil || out == nil || memRows["3"] == nil {
+		t.Fatalf("Update() = %v, %v", out, err)
+	}
+}
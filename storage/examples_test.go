@@ -10,6 +10,7 @@ import (
 	v1 "github.com/vine-io/apimachinery/apis/meta/v1"
 	"github.com/vine-io/apimachinery/runtime"
 	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/schema/fields"
 	"github.com/vine-io/apimachinery/storage/dao"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -327,3 +328,27 @@ func (m *TestStorage) Delete(ctx context.Context, soft bool) error {
 func (m *TestStorage) Tx(ctx context.Context) *gorm.DB {
 	return m.tx.Session(&gorm.Session{}).Table(m.TableName()).WithContext(ctx).Clauses(m.exprs...)
 }
+
+func Test_FieldExpressions(t *testing.T) {
+	gvk := SchemeGroupVersion.WithKind("TestObj")
+	if err := fb.AddFieldMappings(gvk, fields.ObjectMetaMappings("object_meta")); err != nil {
+		t.Fatal(err)
+	}
+
+	exprs, err := fb.FieldExpressions(gvk, fields.OneTermEqualSelector("metadata.name", "foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exprs) != 1 {
+		t.Fatalf("FieldExpressions() = %v", exprs)
+	}
+
+	if _, err = fb.FieldExpressions(gvk, fields.OneTermEqualSelector("spec.name", "foo")); !errors.Is(err, fields.ErrFieldNotSelectable) {
+		t.Fatalf("expected ErrFieldNotSelectable, got %v", err)
+	}
+
+	unknown := SchemeGroupVersion.WithKind("Unknown")
+	if err = fb.AddFieldMappings(unknown, nil); !errors.Is(err, ErrStorageNotExists) {
+		t.Fatalf("expected ErrStorageNotExists, got %v", err)
+	}
+}
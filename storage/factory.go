@@ -23,12 +23,20 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"sync"
 
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
 	"github.com/vine-io/apimachinery/runtime"
 	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/schema/fields"
+	"github.com/vine-io/apimachinery/uid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	gschema "gorm.io/gorm/schema"
 )
 
 var (
@@ -36,14 +44,29 @@ var (
 	ErrStorageIsNotPointer = fmt.Errorf("storage is not a pointer")
 	ErrStorageNotExists    = fmt.Errorf("storage not exists")
 	ErrStorageAutoMigrate  = fmt.Errorf("auto migrate storage")
+	ErrAlreadyExists       = fmt.Errorf("object already exists")
+	ErrInvalidListOptions  = fmt.Errorf("invalid list options")
+	ErrConflict            = fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again")
 )
 
 type GenericStorageFactory struct {
-	gvkToType map[schema.GroupVersionKind]reflect.Type
+	gvkToType     map[schema.GroupVersionKind]reflect.Type
+	fieldMappings map[schema.GroupVersionKind]fields.Mappings
+	uidGenerators map[schema.GroupVersionKind]uid.Generator
+	schemas       sync.Map
 }
 
+// AddKnownStorages registers the Storages and migrates their tables. The callbacks of RegisterCallbacks
+// are registered into tx if absent, which maintain the resourceVersions and timestamps of objects.
 func (s *GenericStorageFactory) AddKnownStorages(tx *gorm.DB, gv schema.GroupVersion, sets ...Storage) error {
 
+	// tx is not opened when the Storages are not backed by database, e.g. in memory
+	if tx != nil && tx.Config != nil && !callbacksRegistered(tx) {
+		if err := RegisterCallbacks(tx); err != nil {
+			return err
+		}
+	}
+
 	for _, storage := range sets {
 		rt := reflect.TypeOf(storage)
 		if rt.Kind() != reflect.Ptr {
@@ -83,6 +106,116 @@ func (s *GenericStorageFactory) IsExists(gvk schema.GroupVersionKind) bool {
 	return ok
 }
 
+// KnownKinds returns schema.GroupVersionKind of all Storages in sorted order.
+func (s *GenericStorageFactory) KnownKinds() []schema.GroupVersionKind {
+	kinds := make([]schema.GroupVersionKind, 0, len(s.gvkToType))
+	for gvk := range s.gvkToType {
+		kinds = append(kinds, gvk)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+	return kinds
+}
+
+// NewObject creates the target object of Storage by schema.GroupVersionKind, the kind of object is set.
+func (s *GenericStorageFactory) NewObject(gvk schema.GroupVersionKind) (runtime.Object, error) {
+	rt, exists := s.gvkToType[gvk]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrStorageNotExists, gvk)
+	}
+
+	target := reflect.New(rt).Interface().(Storage).Target()
+	out, ok := reflect.New(target.Elem()).Interface().(runtime.Object)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v is not a runtime.Object", ErrInvalidObject, target)
+	}
+	out.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return out, nil
+}
+
+// AddFieldMappings registers the selectable fields of Storage, the mappings of the same field are overwritten.
+func (s *GenericStorageFactory) AddFieldMappings(gvk schema.GroupVersionKind, mappings fields.Mappings) error {
+	if !s.IsExists(gvk) {
+		return fmt.Errorf("%w: %s", ErrStorageNotExists, gvk)
+	}
+
+	if _, ok := s.fieldMappings[gvk]; !ok {
+		s.fieldMappings[gvk] = fields.Mappings{}
+	}
+	for field, mapping := range mappings {
+		s.fieldMappings[gvk][field] = mapping
+	}
+	return nil
+}
+
+// FieldExpressions compiles fields.Selector into clause.Expression by the field mappings of schema.GroupVersionKind,
+// the result is passed to Storage.Cond so that FindAll, FindPage and Count honour the selector.
+func (s *GenericStorageFactory) FieldExpressions(gvk schema.GroupVersionKind, selector fields.Selector) ([]clause.Expression, error) {
+	if !s.IsExists(gvk) {
+		return nil, fmt.Errorf("%w: %s", ErrStorageNotExists, gvk)
+	}
+	return fields.ToExpressions(selector, s.fieldMappings[gvk])
+}
+
+// SortExpression compiles sortBy (e.g. "metadata.name,-metadata.namespace") into clause.OrderBy by the
+// field mappings of schema.GroupVersionKind, nil is returned for empty sortBy.
+func (s *GenericStorageFactory) SortExpression(gvk schema.GroupVersionKind, sortBy string) (clause.Expression, error) {
+	if !s.IsExists(gvk) {
+		return nil, fmt.Errorf("%w: %s", ErrStorageNotExists, gvk)
+	}
+	return fields.ToOrderBy(sortBy, s.fieldMappings[gvk])
+}
+
+// List lists the objects of Storage by metav1.ListOptions, see List.
+func (s *GenericStorageFactory) List(ctx context.Context, tx *gorm.DB, gvk schema.GroupVersionKind, opts *metav1.ListOptions) (runtime.Object, error) {
+	return List(ctx, s, tx, gvk, opts)
+}
+
+// MetaColumn returns the column storing metav1.ObjectMeta as JSON of Storage. The column is taken from the
+// field mapping of "metadata.uid", or else from the field of the Storage model implementing metav1.Meta.
+// false is returned when neither of them is found, e.g. metav1.ObjectMeta is inlined into the columns.
+func (s *GenericStorageFactory) MetaColumn(tx *gorm.DB, gvk schema.GroupVersionKind) (string, bool) {
+	rt, exists := s.gvkToType[gvk]
+	if !exists {
+		return "", false
+	}
+	if mapping, ok := s.fieldMappings[gvk]["metadata.uid"]; ok && len(mapping.Path) != 0 {
+		return mapping.Column, true
+	}
+
+	var namer gschema.Namer = gschema.NamingStrategy{}
+	if tx != nil && tx.Config != nil && tx.NamingStrategy != nil {
+		namer = tx.NamingStrategy
+	}
+	model, err := gschema.Parse(reflect.New(rt).Interface(), &s.schemas, namer)
+	if err != nil {
+		return "", false
+	}
+	for _, field := range model.Fields {
+		if field.DBName != "" && isMetaType(field.FieldType) {
+			return field.DBName, true
+		}
+	}
+	return "", false
+}
+
+// SetUIDGenerator registers uid.Generator of Storage, which overrides the default generator of the Uid type
+// (DefaultUIDGenerator or DefaultIntUIDGenerator) in Create.
+func (s *GenericStorageFactory) SetUIDGenerator(gvk schema.GroupVersionKind, generator uid.Generator) error {
+	if !s.IsExists(gvk) {
+		return fmt.Errorf("%w: %s", ErrStorageNotExists, gvk)
+	}
+	s.uidGenerators[gvk] = generator
+	return nil
+}
+
+// UIDGenerator returns uid.Generator of Storage, nil is returned if it isn't registered.
+func (s *GenericStorageFactory) UIDGenerator(gvk schema.GroupVersionKind) uid.Generator {
+	return s.uidGenerators[gvk]
+}
+
 func (s *GenericStorageFactory) AllStorages() []Storage {
 	storages := make([]Storage, 0)
 
@@ -96,6 +229,8 @@ func (s *GenericStorageFactory) AllStorages() []Storage {
 
 func NewStorageFactory() Factory {
 	return &GenericStorageFactory{
-		gvkToType: map[schema.GroupVersionKind]reflect.Type{},
+		gvkToType:     map[schema.GroupVersionKind]reflect.Type{},
+		fieldMappings: map[schema.GroupVersionKind]fields.Mappings{},
+		uidGenerators: map[schema.GroupVersionKind]uid.Generator{},
 	}
 }
@@ -0,0 +1,540 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vine-io/apimachinery/apis/meta"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/storage/dao"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReferencesColumn is the column of metav1.OwnerReferences when metav1.EntityMeta is inlined
+const ReferencesColumn = "references"
+
+// GarbageCollector deletes the dependents of objects by metav1.OwnerReference. Delete queries the
+// dependents of each deleted object by its uid from all Storages of Factory, Sync loads all objects
+// to find the dangling ones.
+type GarbageCollector struct {
+	f Factory
+}
+
+// NewGarbageCollector creates GarbageCollector by Factory
+func NewGarbageCollector(f Factory) *GarbageCollector {
+	return &GarbageCollector{f: f}
+}
+
+// Delete deletes the object as Delete does, and propagates the deletion to its dependents by the
+// metav1.DeletionPropagation, metav1.DeletePropagationBackground is used when propagation is empty.
+// nil is returned when the object is removed immediately.
+//
+// The dependents are deleted synchronously in tx by all policies, they differ in the order:
+//   - metav1.DeletePropagationBackground removes the object first, then its dependents. The object
+//     is removed even if some dependents are held by finalizers.
+//   - metav1.DeletePropagationForeground removes the dependents first, the object is kept and marked
+//     by metav1.FinalizerDeleteDependents until all dependents are removed, see Sync.
+//   - metav1.DeletePropagationOrphan removes the object only and releases its dependents.
+func (gc *GarbageCollector) Delete(ctx context.Context, tx *gorm.DB, in runtime.Object, soft bool, propagation metav1.DeletionPropagation) (runtime.Object, error) {
+	m, err := meta.Accessor(in)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+
+	g := newGraph()
+	owner := g.add(in, m)
+	p := gc.newPass(tx, g, soft)
+	p.visited[owner.uid] = true
+
+	switch propagation {
+	case metav1.DeletePropagationOrphan:
+		dependents, err := p.dependents(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependent := range dependents {
+			if err = p.removeOwners(ctx, dependent, owner.uid); err != nil {
+				return nil, err
+			}
+		}
+		return p.delete(ctx, owner, in)
+	case metav1.DeletePropagationForeground:
+		return p.deleteForeground(ctx, owner, in)
+	case metav1.DeletePropagationBackground, "":
+		return p.deleteBackground(ctx, owner, in)
+	default:
+		return nil, fmt.Errorf("%w: unknown propagation policy %q", ErrInvalidObject, propagation)
+	}
+}
+
+// Sync runs a pass of garbage collection:
+//   - the dependents whose owners are all deleted are deleted in background, the dangling
+//     OwnerReferences of others are removed.
+//   - the objects marked by metav1.FinalizerDeleteDependents are removed once their dependents are deleted.
+func (gc *GarbageCollector) Sync(ctx context.Context, tx *gorm.DB, soft bool) error {
+	g, err := gc.buildGraph(ctx, tx)
+	if err != nil {
+		return err
+	}
+	p := gc.newPass(tx, g, soft)
+
+	for _, n := range g.order {
+		if _, ok := g.nodes[n.uid]; !ok || p.visited[n.uid] {
+			continue
+		}
+
+		m, _ := meta.Accessor(n.object)
+		if m.GetDeletionTimestamp() != 0 && meta.ContainsFinalizer(m, metav1.FinalizerDeleteDependents) {
+			p.visited[n.uid] = true
+			if _, err = p.deleteForeground(ctx, n, n.object); err != nil {
+				return err
+			}
+			continue
+		}
+
+		dangling, err := p.danglingOwners(ctx, n)
+		if err != nil {
+			return err
+		}
+		if len(dangling) == 0 {
+			continue
+		}
+		if len(dangling) < len(n.owners) {
+			if err = p.removeOwners(ctx, n, dangling...); err != nil {
+				return err
+			}
+			continue
+		}
+		p.visited[n.uid] = true
+		if _, err = p.deleteBackground(ctx, n, n.object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// node is the vertex of dependency graph
+type node struct {
+	uid        string
+	object     runtime.Object
+	owners     []*metav1.OwnerReference
+	dependents []*node
+	// loaded is set once the dependents are queried
+	loaded bool
+}
+
+// graph is the dependency graph of objects built by metav1.OwnerReference
+type graph struct {
+	nodes map[string]*node
+	// order keeps the nodes in the order of loading
+	order []*node
+	// complete is set when all objects are loaded, the owners absent from graph are dangling
+	complete bool
+	// removed holds the uid of objects removed by the collection
+	removed map[string]bool
+}
+
+func newGraph() *graph {
+	return &graph{nodes: map[string]*node{}, removed: map[string]bool{}}
+}
+
+// add adds the object to graph, the node loaded before is returned if present
+func (g *graph) add(object runtime.Object, m metav1.Meta) *node {
+	uid := uidOf(m)
+	if n, ok := g.nodes[uid]; ok {
+		return n
+	}
+	n := &node{uid: uid, object: object, owners: m.GetReferences()}
+	g.nodes[uid] = n
+	g.order = append(g.order, n)
+	return n
+}
+
+func (g *graph) remove(n *node) {
+	delete(g.nodes, n.uid)
+	g.removed[n.uid] = true
+}
+
+// buildGraph loads all objects of Storages in Factory and links them by their OwnerReferences
+func (gc *GarbageCollector) buildGraph(ctx context.Context, tx *gorm.DB) (*graph, error) {
+	g := newGraph()
+
+	for _, gvk := range gc.f.KnownKinds() {
+		err := gc.eachObject(ctx, tx, gvk, nil, func(item runtime.Object, m metav1.Meta) {
+			g.add(item, m)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, n := range g.order {
+		n.loaded = true
+		for _, ref := range n.owners {
+			if owner, ok := g.nodes[ref.Uid]; ok {
+				owner.dependents = append(owner.dependents, n)
+			}
+		}
+	}
+	g.complete = true
+
+	return g, nil
+}
+
+// eachObject calls fn with the objects of Storage matched by the expression, all objects are
+// loaded when expr is nil.
+func (gc *GarbageCollector) eachObject(ctx context.Context, tx *gorm.DB, gvk schema.GroupVersionKind, expr func(runtime.Object) clause.Expression, fn func(runtime.Object, metav1.Meta)) error {
+	in, err := gc.f.NewObject(gvk)
+	if err != nil {
+		return err
+	}
+	s, err := gc.f.NewStorage(tx, in)
+	if err != nil {
+		return err
+	}
+	if expr != nil {
+		if e := expr(in); e != nil {
+			s = s.Cond(e)
+		}
+	}
+	list, err := s.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	return meta.EachListItem(list, func(item runtime.Object) error {
+		m, err := meta.Accessor(item)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidObject, err)
+		}
+		item.GetObjectKind().SetGroupVersionKind(gvk)
+		fn(item, m)
+		return nil
+	})
+}
+
+// referencesExpression returns the condition matching the objects owned by uid, the column of
+// metav1.ObjectMeta is resolved by Factory.MetaColumn. nil is returned when the metadata or its column
+// is unknown, all objects of Storage are loaded and the dependents are filtered in memory then, which
+// costs a full table scan for every node of the graph.
+func (gc *GarbageCollector) referencesExpression(tx *gorm.DB, obj runtime.Object, uid string) clause.Expression {
+	switch embeddedMeta(obj).(type) {
+	case *metav1.ObjectMeta:
+		column, ok := gc.f.MetaColumn(tx, obj.GetObjectKind().GroupVersionKind())
+		if !ok {
+			return nil
+		}
+		return dao.JSONQuery(column).HasElement("uid", uid, "references")
+	case *metav1.EntityMeta:
+		return dao.JSONQuery(ReferencesColumn).HasElement("uid", uid)
+	}
+	return nil
+}
+
+// collectPass holds the state of a single garbage collection, visited prevents the
+// cycles of OwnerReferences and deleting holds the nodes in foreground deletion.
+type collectPass struct {
+	gc       *GarbageCollector
+	tx       *gorm.DB
+	g        *graph
+	soft     bool
+	visited  map[string]bool
+	deleting map[string]bool
+}
+
+func (gc *GarbageCollector) newPass(tx *gorm.DB, g *graph, soft bool) *collectPass {
+	return &collectPass{gc: gc, tx: tx, g: g, soft: soft, visited: map[string]bool{}, deleting: map[string]bool{}}
+}
+
+// dependents returns the dependents of node. They are queried by the uid of node from all Storages
+// unless the graph is complete.
+func (p *collectPass) dependents(ctx context.Context, n *node) ([]*node, error) {
+	if p.g.complete || n.loaded {
+		return n.dependents, nil
+	}
+
+	expr := func(obj runtime.Object) clause.Expression { return p.gc.referencesExpression(p.tx, obj, n.uid) }
+	for _, gvk := range p.gc.f.KnownKinds() {
+		err := p.gc.eachObject(ctx, p.tx, gvk, expr, func(item runtime.Object, m metav1.Meta) {
+			if p.g.removed[uidOf(m)] {
+				return
+			}
+			if dependent := p.g.add(item, m); hasOwner(dependent, n.uid) {
+				n.dependents = append(n.dependents, dependent)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	n.loaded = true
+
+	return n.dependents, nil
+}
+
+// delete deletes the object of node, and removes the node from graph when the object is removed.
+func (p *collectPass) delete(ctx context.Context, n *node, in runtime.Object) (runtime.Object, error) {
+	out, err := Delete(ctx, p.gc.f, p.tx, in, p.soft)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		p.g.remove(n)
+	} else {
+		n.object = out
+	}
+	return out, nil
+}
+
+// deleteBackground deletes the object of node, then the dependents left without owners.
+func (p *collectPass) deleteBackground(ctx context.Context, n *node, in runtime.Object) (runtime.Object, error) {
+	out, err := p.delete(ctx, n, in)
+	if err != nil || out != nil {
+		// the dependents are collected by Sync once the object is removed
+		return out, err
+	}
+
+	if err = p.collectDependents(ctx, n, p.deleteBackground); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// deleteForeground deletes the dependents of node first, the object is marked by
+// metav1.FinalizerDeleteDependents while some dependents are still present.
+func (p *collectPass) deleteForeground(ctx context.Context, n *node, in runtime.Object) (runtime.Object, error) {
+	p.deleting[n.uid] = true
+	defer delete(p.deleting, n.uid)
+
+	if err := p.collectDependents(ctx, n, p.deleteForeground); err != nil {
+		return nil, err
+	}
+
+	remains := false
+	for _, dependent := range n.dependents {
+		if _, ok := p.g.nodes[dependent.uid]; !ok || p.deleting[dependent.uid] {
+			// the dependent in the cycle of OwnerReferences doesn't block the deletion
+			continue
+		}
+		if hasOwner(dependent, n.uid) {
+			remains = true
+			break
+		}
+	}
+
+	m, _ := meta.Accessor(in)
+	if remains {
+		if !meta.ContainsFinalizer(m, metav1.FinalizerDeleteDependents) {
+			in = in.DeepCopyObject()
+			m, _ = meta.Accessor(in)
+			meta.AddFinalizer(m, metav1.FinalizerDeleteDependents)
+			out, err := Update(ctx, p.gc.f, p.tx, in, p.soft)
+			if err != nil {
+				return nil, err
+			}
+			in = out
+		}
+		return p.delete(ctx, n, in)
+	}
+
+	if meta.ContainsFinalizer(m, metav1.FinalizerDeleteDependents) {
+		in = in.DeepCopyObject()
+		m, _ = meta.Accessor(in)
+		meta.RemoveFinalizer(m, metav1.FinalizerDeleteDependents)
+		out, err := Update(ctx, p.gc.f, p.tx, in, p.soft)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			p.g.remove(n)
+			return nil, nil
+		}
+		if om, _ := meta.Accessor(out); om.GetDeletionTimestamp() != 0 {
+			// the object is held by other finalizers
+			n.object = out
+			return out, nil
+		}
+		in = out
+	}
+	return p.delete(ctx, n, in)
+}
+
+// collectDependents deletes the dependents of node by fn, the dependents still holding
+// other owners are only released from the node.
+func (p *collectPass) collectDependents(ctx context.Context, n *node, fn func(context.Context, *node, runtime.Object) (runtime.Object, error)) error {
+	dependents, err := p.dependents(ctx, n)
+	if err != nil {
+		return err
+	}
+
+	for _, dependent := range dependents {
+		if _, ok := p.g.nodes[dependent.uid]; !ok || p.visited[dependent.uid] {
+			continue
+		}
+
+		others, err := p.hasOtherOwners(ctx, dependent, n.uid)
+		if err != nil {
+			return err
+		}
+		if others {
+			if err := p.removeOwners(ctx, dependent, n.uid); err != nil {
+				return err
+			}
+			continue
+		}
+
+		p.visited[dependent.uid] = true
+		if _, err := fn(ctx, dependent, dependent.object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeOwners removes the OwnerReferences of owners from the object of node
+func (p *collectPass) removeOwners(ctx context.Context, n *node, owners ...string) error {
+	in := n.object.DeepCopyObject()
+	m, _ := meta.Accessor(in)
+
+	refs := make([]*metav1.OwnerReference, 0, len(n.owners))
+	for _, ref := range m.GetReferences() {
+		if !containsString(owners, ref.Uid) {
+			refs = append(refs, ref)
+		}
+	}
+	m.SetReferences(refs)
+
+	out, err := Update(ctx, p.gc.f, p.tx, in, p.soft)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		p.g.remove(n)
+		return nil
+	}
+	n.object = out
+	n.owners = refs
+	return nil
+}
+
+// hasOtherOwners checks if the node holds any alive owner except the given one
+func (p *collectPass) hasOtherOwners(ctx context.Context, n *node, owner string) (bool, error) {
+	dangling, err := p.danglingOwners(ctx, n)
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range n.owners {
+		if ref.Uid != owner && !containsString(dangling, ref.Uid) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// danglingOwners returns the uid of owners that are not present. The owner absent from graph is
+// queried by its uid unless the graph is complete, the owner whose kind is unknown to Factory is
+// regarded as present.
+func (p *collectPass) danglingOwners(ctx context.Context, n *node) ([]string, error) {
+	dangling := make([]string, 0)
+	for _, ref := range n.owners {
+		if _, ok := p.g.nodes[ref.Uid]; ok {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.ApiVersion)
+		if err != nil || !p.gc.f.IsExists(gv.WithKind(ref.Kind)) {
+			continue
+		}
+		if !p.g.complete && !p.g.removed[ref.Uid] {
+			found, err := p.loadOwner(ctx, gv.WithKind(ref.Kind), ref.Uid)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				continue
+			}
+		}
+		dangling = append(dangling, ref.Uid)
+	}
+	return dangling, nil
+}
+
+// loadOwner adds the owner to graph if it's present
+func (p *collectPass) loadOwner(ctx context.Context, gvk schema.GroupVersionKind, uid string) (bool, error) {
+	pk, err := parseUID(p.gc.f, gvk, uid)
+	if err != nil {
+		return false, err
+	}
+	in, err := p.gc.f.NewObject(gvk)
+	if err != nil {
+		return false, err
+	}
+	s, err := p.gc.f.NewStorage(p.tx, in)
+	if err != nil {
+		return false, err
+	}
+	out, err := s.FindPk(ctx, pk)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	m, err := meta.Accessor(out)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(gvk)
+	p.g.add(out, m)
+	return true, nil
+}
+
+// hasOwner checks if the node is owned by the owner
+func hasOwner(n *node, owner string) bool {
+	for _, ref := range n.owners {
+		if ref.Uid == owner {
+			return true
+		}
+	}
+	return false
+}
+
+func uidOf(m metav1.Meta) string {
+	return fmt.Sprintf("%v", m.GetUID())
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
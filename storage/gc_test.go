@@ -0,0 +1,253 @@
+package storage
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema/fields"
+	"gorm.io/gorm"
+)
+
+func TestGarbageCollector(t *testing.T) {
+	ctx := context.TODO()
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	gvk := SchemeGroupVersion.WithKind("MemObj")
+	if err := f.AddFieldMappings(gvk, fields.ObjectMetaMappings("object_meta")); err != nil {
+		t.Fatal(err)
+	}
+	gc := NewGarbageCollector(f)
+
+	ref := func(uid string) *metav1.OwnerReference {
+		return &metav1.OwnerReference{ApiVersion: gvk.GroupVersion().String(), Kind: gvk.Kind, Uid: uid}
+	}
+	newObj := func(uid string, finalizers []string, owners ...string) *MemObj {
+		o := &MemObj{ObjectMeta: metav1.ObjectMeta{Uid: uid, Finalizers: finalizers}}
+		for _, owner := range owners {
+			o.References = append(o.References, ref(owner))
+		}
+		o.SetGroupVersionKind(gvk)
+		memRows[uid] = o.DeepCopyObject().(*MemObj)
+		return o
+	}
+	rows := func() []string {
+		uids := make([]string, 0)
+		for uid := range memRows {
+			uids = append(uids, uid)
+		}
+		sort.Strings(uids)
+		return uids
+	}
+	// setup creates the graph: a <- b <- c, a <- d, e <- d
+	setup := func() *MemObj {
+		memRows = map[string]*MemObj{}
+		memScans = 0
+		a := newObj("a", nil)
+		newObj("b", nil, "a")
+		newObj("c", nil, "b")
+		newObj("d", nil, "a", "e")
+		newObj("e", nil)
+		return a
+	}
+
+	tests := []struct {
+		name        string
+		propagation metav1.DeletionPropagation
+		want        []string
+	}{
+		{name: "background", propagation: metav1.DeletePropagationBackground, want: []string{"d", "e"}},
+		{name: "default", want: []string{"d", "e"}},
+		{name: "foreground", propagation: metav1.DeletePropagationForeground, want: []string{"d", "e"}},
+		{name: "orphan", propagation: metav1.DeletePropagationOrphan, want: []string{"b", "c", "d", "e"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := gc.Delete(ctx, nil, setup(), true, tt.propagation)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != nil {
+				t.Fatalf("Delete() = %v, want nil", out)
+			}
+			if got := rows(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("rows = %v, want %v", got, tt.want)
+			}
+			if refs := memRows["d"].References; len(refs) != 1 || refs[0].Uid != "e" {
+				t.Fatalf("references of d = %v", refs)
+			}
+			if tt.propagation == metav1.DeletePropagationOrphan && len(memRows["b"].References) != 0 {
+				t.Fatalf("b should be orphaned, got %v", memRows["b"].References)
+			}
+			if memScans != 0 {
+				t.Fatalf("dependents should be queried by references, got %d scans", memScans)
+			}
+		})
+	}
+
+	t.Run("background with finalizers", func(t *testing.T) {
+		a := setup()
+		newObj("c", []string{"x"}, "b")
+
+		// unlike foreground, a is removed before its dependents, c is only marked
+		out, err := gc.Delete(ctx, nil, a, true, metav1.DeletePropagationBackground)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != nil {
+			t.Fatalf("Delete() = %v, want nil", out)
+		}
+		if got := rows(); !reflect.DeepEqual(got, []string{"c", "d", "e"}) {
+			t.Fatalf("rows = %v", got)
+		}
+		if memRows["c"].DeletionTimestamp == 0 {
+			t.Fatalf("c should be marked, got %v", memRows["c"])
+		}
+	})
+
+	t.Run("foreground blocked by finalizers", func(t *testing.T) {
+		a := setup()
+		newObj("c", []string{"x"}, "b")
+
+		out, err := gc.Delete(ctx, nil, a, true, metav1.DeletePropagationForeground)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out == nil || !reflect.DeepEqual([]string(memRows["a"].Finalizers), []string{metav1.FinalizerDeleteDependents}) || memRows["a"].DeletionTimestamp == 0 {
+			t.Fatalf("a should be marked, got %v", memRows["a"])
+		}
+		if memRows["c"].DeletionTimestamp == 0 {
+			t.Fatalf("c should be marked, got %v", memRows["c"])
+		}
+
+		// the finalizer of c is cleared, Sync removes b and a
+		c := memRows["c"].DeepCopyObject().(*MemObj)
+		c.Finalizers = nil
+		if _, err = Update(ctx, f, nil, c, true); err != nil {
+			t.Fatal(err)
+		}
+		if err = gc.Sync(ctx, nil, true); err != nil {
+			t.Fatal(err)
+		}
+		if got := rows(); !reflect.DeepEqual(got, []string{"d", "e"}) {
+			t.Fatalf("rows = %v", got)
+		}
+	})
+
+	t.Run("sync dangling", func(t *testing.T) {
+		a := setup()
+		s, err := f.NewStorage(nil, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = s.Delete(ctx, true); err != nil {
+			t.Fatal(err)
+		}
+		// the owner of unknown kind is regarded as present
+		newObj("f", nil, "a")
+		memRows["f"].References[0].Kind = "Unknown"
+
+		if err = gc.Sync(ctx, nil, true); err != nil {
+			t.Fatal(err)
+		}
+		if got := rows(); !reflect.DeepEqual(got, []string{"d", "e", "f"}) {
+			t.Fatalf("rows = %v", got)
+		}
+		if refs := memRows["d"].References; len(refs) != 1 || refs[0].Uid != "e" {
+			t.Fatalf("references of d = %v", refs)
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		memRows = map[string]*MemObj{}
+		a := newObj("a", nil, "b")
+		newObj("b", nil, "a")
+		if _, err := gc.Delete(ctx, nil, a, true, metav1.DeletePropagationForeground); err != nil {
+			t.Fatal(err)
+		}
+		if got := rows(); len(got) != 0 {
+			t.Fatalf("rows = %v", got)
+		}
+	})
+
+	t.Run("unknown metadata column", func(t *testing.T) {
+		f := NewStorageFactory()
+		if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+			t.Fatal(err)
+		}
+
+		// all objects are loaded for every node without the column of references
+		if _, err := NewGarbageCollector(f).Delete(ctx, nil, setup(), true, metav1.DeletePropagationBackground); err != nil {
+			t.Fatal(err)
+		}
+		if got := rows(); !reflect.DeepEqual(got, []string{"d", "e"}) {
+			t.Fatalf("rows = %v", got)
+		}
+		if memScans == 0 {
+			t.Fatal("dependents should be filtered after loading")
+		}
+	})
+}
+
+// modelStorage stores metav1.ObjectMeta in the JSON column of its model
+type modelStorage struct {
+	memStorage
+	Metadata *metav1.ObjectMeta `gorm:"column:meta"`
+}
+
+func TestReferencesExpression(t *testing.T) {
+	db, _ := openExecDB(t)
+	gvk := SchemeGroupVersion.WithKind("MemObj")
+
+	tests := []struct {
+		name     string
+		storage  Storage
+		mappings fields.Mappings
+		want     string
+	}{
+		{name: "mappings", storage: &memStorage{}, mappings: fields.ObjectMetaMappings("object_meta"), want: "object_meta"},
+		{name: "model", storage: &modelStorage{}, want: "meta"},
+		{name: "unknown", storage: &memStorage{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewStorageFactory()
+			if err := f.AddKnownStorages(nil, SchemeGroupVersion, tt.storage); err != nil {
+				t.Fatal(err)
+			}
+			if tt.mappings != nil {
+				if err := f.AddFieldMappings(gvk, tt.mappings); err != nil {
+					t.Fatal(err)
+				}
+			}
+			obj, err := f.NewObject(gvk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expr := NewGarbageCollector(f).referencesExpression(db, obj, "a")
+			if tt.want == "" {
+				if expr != nil {
+					t.Fatalf("referencesExpression() = %v, want nil", expr)
+				}
+				return
+			}
+			if expr == nil {
+				t.Fatal("referencesExpression() = nil")
+			}
+			stmt := db.Session(&gorm.Session{DryRun: true}).Table("mem_objs").Where(expr).Find(&[]map[string]interface{}{}).Statement
+			want := `EXISTS (SELECT 1 FROM JSON_EACH("` + tt.want + `",?) WHERE JSON_EXTRACT(JSON_EACH.value,?) = ?)`
+			if sql := stmt.SQL.String(); !strings.Contains(sql, want) {
+				t.Fatalf("SQL %s\ndoes not contain %s", sql, want)
+			}
+			if want := []interface{}{"$.references", "$.uid", "a"}; !reflect.DeepEqual(stmt.Vars, want) {
+				t.Fatalf("vars = %v, want %v", stmt.Vars, want)
+			}
+		})
+	}
+}
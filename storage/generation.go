@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"reflect"
+
+	json "github.com/json-iterator/go"
+	"github.com/vine-io/apimachinery/apis/meta"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+)
+
+// nextGeneration returns the generation of the updated object, the generation of current object
+// is increased when the fields except metadata and status are changed.
+func nextGeneration(in, current runtime.Object) (int64, error) {
+	cm, err := meta.Accessor(current)
+	if err != nil {
+		return 0, err
+	}
+	generation := cm.GetGeneration()
+
+	spec, err := specOf(in)
+	if err != nil {
+		return 0, err
+	}
+	currentSpec, err := specOf(current)
+	if err != nil {
+		return 0, err
+	}
+	if !reflect.DeepEqual(spec, currentSpec) {
+		generation++
+	}
+	return generation, nil
+}
+
+// specOf returns the json fields of the object except type, metadata and status. The fields of
+// metadata are removed from the top level when the metadata is inlined, e.g. metav1.EntityMeta.
+func specOf(obj runtime.Object) (map[string]any, error) {
+	out := map[string]any{}
+	if err := reencode(obj, &out); err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"apiVersion", "kind", "status"} {
+		delete(out, key)
+	}
+
+	if _, ok := out["metadata"]; ok {
+		delete(out, "metadata")
+		return out, nil
+	}
+
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if any(m) == any(obj) {
+		// the object implements metav1.Meta by the embedded metadata
+		if m = embeddedMeta(obj); m == nil {
+			return out, nil
+		}
+	}
+	fields := map[string]any{}
+	if err = reencode(m, &fields); err != nil {
+		return nil, err
+	}
+	for key := range fields {
+		delete(out, key)
+	}
+	return out, nil
+}
+
+// embeddedMeta returns the anonymous field of the object which implements metav1.Meta
+func embeddedMeta(obj runtime.Object) metav1.Meta {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.Anonymous || !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		if fv.Kind() != reflect.Pointer {
+			if !fv.CanAddr() {
+				continue
+			}
+			fv = fv.Addr()
+		} else if fv.IsNil() {
+			continue
+		}
+		if m, ok := fv.Interface().(metav1.Meta); ok {
+			return m
+		}
+	}
+	return nil
+}
+
+func reencode(in, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
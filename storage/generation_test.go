@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+func TestGeneration(t *testing.T) {
+	ctx := context.TODO()
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	memRows = map[string]*MemObj{}
+
+	in := &MemObj{ObjectMeta: metav1.ObjectMeta{Uid: "1", Name: "o1", Generation: 5}, Replicas: 1}
+	in.SetGroupVersionKind(SchemeGroupVersion.WithKind("MemObj"))
+	out, err := Create(ctx, f, nil, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := out.(*MemObj).Generation; g != 1 {
+		t.Fatalf("Generation after Create = %d, want 1", g)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(o *MemObj)
+		want   int64
+	}{
+		{name: "metadata", mutate: func(o *MemObj) { o.Labels = map[string]string{"a": "b"} }, want: 1},
+		{name: "status", mutate: func(o *MemObj) { o.Status.ObservedGeneration = 1 }, want: 1},
+		{name: "generation by client", mutate: func(o *MemObj) { o.Generation = 10 }, want: 1},
+		{name: "spec", mutate: func(o *MemObj) { o.Replicas = 3 }, want: 2},
+		{name: "spec and status", mutate: func(o *MemObj) { o.Replicas = 4; o.Status.ObservedGeneration = 2 }, want: 3},
+		{name: "nothing", mutate: func(o *MemObj) {}, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := memRows["1"].DeepCopyObject().(*MemObj)
+			o.SetGroupVersionKind(SchemeGroupVersion.WithKind("MemObj"))
+			tt.mutate(o)
+			out, err := Update(ctx, f, nil, o, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := out.(*MemObj).Generation; g != tt.want {
+				t.Fatalf("Generation = %d, want %d", g, tt.want)
+			}
+		})
+	}
+}
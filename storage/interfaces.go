@@ -26,8 +26,11 @@ import (
 	"context"
 	"reflect"
 
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
 	"github.com/vine-io/apimachinery/runtime"
 	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/schema/fields"
+	"github.com/vine-io/apimachinery/uid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -68,8 +71,36 @@ type Factory interface {
 	// IsExists checks Storage exists
 	IsExists(gvk schema.GroupVersionKind) bool
 
+	// KnownKinds returns schema.GroupVersionKind of all Storages
+	KnownKinds() []schema.GroupVersionKind
+
+	// NewObject creates the target object of Storage by schema.GroupVersionKind
+	NewObject(gvk schema.GroupVersionKind) (runtime.Object, error)
+
 	// AllStorages returns all Storages
 	AllStorages() []Storage
+
+	// AddFieldMappings registers the selectable fields of Storage
+	AddFieldMappings(gvk schema.GroupVersionKind, mappings fields.Mappings) error
+
+	// FieldExpressions compiles fields.Selector into clause.Expression passed to Storage.Cond
+	FieldExpressions(gvk schema.GroupVersionKind, selector fields.Selector) ([]clause.Expression, error)
+
+	// SortExpression compiles sortBy into clause.OrderBy passed to Storage.Cond
+	SortExpression(gvk schema.GroupVersionKind, sortBy string) (clause.Expression, error)
+
+	// List lists the objects of Storage by metav1.ListOptions, the selectors are compiled by the
+	// field mappings since Storage only accepts clause.Expression
+	List(ctx context.Context, tx *gorm.DB, gvk schema.GroupVersionKind, opts *metav1.ListOptions) (runtime.Object, error)
+
+	// MetaColumn returns the column storing metav1.ObjectMeta as JSON of Storage
+	MetaColumn(tx *gorm.DB, gvk schema.GroupVersionKind) (string, bool)
+
+	// SetUIDGenerator registers uid.Generator of Storage used by Create
+	SetUIDGenerator(gvk schema.GroupVersionKind, generator uid.Generator) error
+
+	// UIDGenerator returns uid.Generator of Storage, nil is returned if it isn't registered
+	UIDGenerator(gvk schema.GroupVersionKind) uid.Generator
 }
 
 type EmptyHook struct{}
@@ -0,0 +1,159 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/vine-io/apimachinery/apis/meta"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/schema/fields"
+	"github.com/vine-io/apimachinery/schema/labels"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// List lists the objects of schema.GroupVersionKind by metav1.ListOptions:
+//   - LabelSelector is applied to the labels of metav1.ObjectMeta stored in the column of Factory.MetaColumn.
+//   - FieldSelector and SortBy are compiled by the field mappings registered in Factory.
+//   - Limit and Continue chunk the list, the Continue of returned list is set when more objects remain.
+//     The token holds the offset of next chunk, so the objects may be skipped or repeated across
+//     chunks when others are created or deleted concurrently.
+//   - ResourceVersion isn't honoured since Storage keeps no history, the latest objects are returned.
+//   - TimeoutSeconds bounds the context of queries.
+func List(ctx context.Context, f Factory, tx *gorm.DB, gvk schema.GroupVersionKind, opts *metav1.ListOptions) (runtime.Object, error) {
+	if opts == nil {
+		opts = &metav1.ListOptions{}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidListOptions, err)
+	}
+	if opts.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(opts.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	ls, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidListOptions, err)
+	}
+	fs, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidListOptions, err)
+	}
+	offset, err := decodeContinue(opts.Continue)
+	if err != nil {
+		return nil, err
+	}
+
+	exprs, err := f.FieldExpressions(gvk, fs)
+	if err != nil {
+		return nil, err
+	}
+	if !ls.Empty() {
+		column, ok := f.MetaColumn(tx, gvk)
+		if !ok {
+			return nil, fmt.Errorf("%w: labels of %s are not selectable", ErrInvalidListOptions, gvk)
+		}
+		exprs = append(exprs, labels.ToExpressions(ls, column, labels.DefaultPath...)...)
+	}
+	order, err := f.SortExpression(gvk, opts.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// queries by an empty object, the fields of object are not taken as conditions
+	probe, err := f.NewObject(gvk)
+	if err != nil {
+		return nil, err
+	}
+	s, err := f.NewStorage(tx, probe)
+	if err != nil {
+		return nil, err
+	}
+
+	s = s.Cond(exprs...)
+	total, err := s.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if order != nil {
+		s = s.Cond(order)
+	}
+	if opts.Limit > 0 || offset > 0 {
+		limit := clause.Limit{Offset: int(offset)}
+		if opts.Limit > 0 {
+			n := int(opts.Limit)
+			limit.Limit = &n
+		}
+		s = s.Cond(limit)
+	}
+
+	list, err := s.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lm, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	lm.SetTotal(total)
+	if next := offset + int64(meta.LenList(list)); opts.Limit > 0 && next < total {
+		lm.SetContinue(encodeContinue(next))
+	}
+
+	return list, nil
+}
+
+// continueToken is the content of metav1.ListMeta.Continue
+type continueToken struct {
+	Offset int64 `json:"offset"`
+}
+
+func encodeContinue(offset int64) string {
+	data, _ := json.Marshal(&continueToken{Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeContinue(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid continue token: %v", ErrInvalidListOptions, err)
+	}
+	ct := &continueToken{}
+	if err = json.Unmarshal(data, ct); err != nil || ct.Offset < 0 {
+		return 0, fmt.Errorf("%w: invalid continue token %q", ErrInvalidListOptions, token)
+	}
+	return ct.Offset, nil
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/schema/fields"
+)
+
+func TestList(t *testing.T) {
+	ctx := context.TODO()
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	gvk := SchemeGroupVersion.WithKind("MemObj")
+	if err := f.AddFieldMappings(gvk, fields.ObjectMetaMappings("object_meta")); err != nil {
+		t.Fatal(err)
+	}
+
+	memRows = map[string]*MemObj{}
+	for i := 0; i < 5; i++ {
+		uid := fmt.Sprintf("%d", i)
+		memRows[uid] = &MemObj{ObjectMeta: metav1.ObjectMeta{Uid: uid}}
+	}
+
+	// lists in chunks
+	opts := &metav1.ListOptions{Limit: 2, LabelSelector: "app=foo", FieldSelector: "metadata.namespace=default", SortBy: "-metadata.name"}
+	uids := make([]string, 0)
+	for chunks := 0; ; chunks++ {
+		if chunks > 3 {
+			t.Fatal("too many chunks")
+		}
+		out, err := List(ctx, f, nil, gvk, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list := out.(*MemObjList)
+		if list.Total != 5 {
+			t.Fatalf("Total = %d, want 5", list.Total)
+		}
+		for _, item := range list.Items {
+			uids = append(uids, item.Uid)
+		}
+		if list.Continue == "" {
+			break
+		}
+		opts.Continue = list.Continue
+	}
+	if fmt.Sprint(uids) != "[0 1 2 3 4]" {
+		t.Fatalf("uids = %v", uids)
+	}
+
+	// lists all without limit
+	out, err := f.List(ctx, nil, gvk, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := out.(*MemObjList); len(list.Items) != 5 || list.Continue != "" {
+		t.Fatalf("List() = %v", list)
+	}
+
+	tests := []struct {
+		name string
+		opts *metav1.ListOptions
+		want error
+	}{
+		{name: "negative limit", opts: &metav1.ListOptions{Limit: -1}, want: ErrInvalidListOptions},
+		{name: "invalid continue", opts: &metav1.ListOptions{Continue: "%%"}, want: ErrInvalidListOptions},
+		{name: "invalid label selector", opts: &metav1.ListOptions{LabelSelector: "a in (b"}, want: ErrInvalidListOptions},
+		{name: "unknown field", opts: &metav1.ListOptions{FieldSelector: "spec.name=foo"}, want: fields.ErrFieldNotSelectable},
+		{name: "unknown sort field", opts: &metav1.ListOptions{SortBy: "spec.name"}, want: fields.ErrFieldNotSortable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := List(ctx, f, nil, gvk, tt.opts); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// labels aren't selectable without the column of metav1.ObjectMeta
+	unmapped := NewStorageFactory()
+	if err := unmapped.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := List(ctx, unmapped, nil, gvk, &metav1.ListOptions{LabelSelector: "app=foo"}); !errors.Is(err, ErrInvalidListOptions) {
+		t.Fatalf("expected %v, got %v", ErrInvalidListOptions, err)
+	}
+}
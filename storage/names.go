@@ -0,0 +1,96 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+)
+
+// NameGenerator generates names for objects. Some backends may have more information available to
+// guide selection of new names and this interface hides those details.
+type NameGenerator interface {
+	// GenerateName generates a valid name from the base name, adding a random suffix to
+	// the base. If base is valid, the returned name must also be valid. The generator is
+	// responsible for knowing the maximum valid name length.
+	GenerateName(base string) string
+}
+
+// SimpleNameGenerator generates random names by appending a random alphanumeric suffix to the base
+var SimpleNameGenerator NameGenerator = simpleNameGenerator{}
+
+// DefaultNameGenerator is the NameGenerator used by Create
+var DefaultNameGenerator = SimpleNameGenerator
+
+// MaxNameGenerationAttempts is the number of names generated from GenerateName before giving up
+const MaxNameGenerationAttempts = 8
+
+const (
+	maxNameLength          = 63
+	randomLength           = 5
+	maxGeneratedNameLength = maxNameLength - randomLength
+)
+
+// alphanums omits vowels and the characters easily confused, so the random suffix won't form bad words
+const alphanums = "bcdfghjklmnpqrstvwxz2456789"
+
+var rng = struct {
+	sync.Mutex
+	rand *rand.Rand
+}{
+	rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+}
+
+type simpleNameGenerator struct{}
+
+func (simpleNameGenerator) GenerateName(base string) string {
+	if len(base) > maxGeneratedNameLength {
+		base = base[:maxGeneratedNameLength]
+	}
+	return base + randomString(randomLength)
+}
+
+// randomString generates a random alphanumeric string of n characters
+func randomString(n int) string {
+	rng.Lock()
+	defer rng.Unlock()
+
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphanums[rng.rand.Intn(len(alphanums))]
+	}
+	return string(b)
+}
+
+// GenerateName sets the Name of object generated from its GenerateName by DefaultNameGenerator,
+// false is returned when the Name is present or GenerateName is empty.
+func GenerateName(m metav1.Meta) bool {
+	if m.GetName() != "" || m.GetGenerateName() == "" {
+		return false
+	}
+	m.SetName(DefaultNameGenerator.GenerateName(m.GetGenerateName()))
+	return true
+}
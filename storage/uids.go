@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/vine-io/apimachinery/apis/meta"
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/runtime"
+	"github.com/vine-io/apimachinery/schema"
+	"github.com/vine-io/apimachinery/uid"
+)
+
+var (
+	// DefaultUIDGenerator generates the string Uid (e.g. metav1.ObjectMeta) for the Storages without uid.Generator
+	DefaultUIDGenerator uid.Generator = uid.UUIDv4
+	// DefaultIntUIDGenerator generates the int64 Uid (e.g. metav1.EntityMeta) for the Storages without uid.Generator
+	DefaultIntUIDGenerator uid.Generator = mustSnowflake(0)
+)
+
+func mustSnowflake(node int64) *uid.Snowflake {
+	s, err := uid.NewSnowflake(node)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// assignUID sets the Uid of object if it's empty. The uid.Generator registered in Factory is used,
+// otherwise the default generator is chosen by the Uid type of the target object of Storage.
+func assignUID(f Factory, in runtime.Object, m metav1.Meta) error {
+	if current := m.GetUID(); current != nil && !reflect.ValueOf(current).IsZero() {
+		return nil
+	}
+
+	gvk := in.GetObjectKind().GroupVersionKind()
+	target, err := f.NewObject(gvk)
+	if err != nil {
+		return err
+	}
+	tm, err := meta.Accessor(target)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+	zero := tm.GetUID()
+
+	generator := f.UIDGenerator(gvk)
+	if generator == nil {
+		switch zero.(type) {
+		case string:
+			generator = DefaultUIDGenerator
+		case int64:
+			generator = DefaultIntUIDGenerator
+		default:
+			// the Uid is left to Storage
+			return nil
+		}
+	}
+
+	id, err := generator.NewUID()
+	if err != nil {
+		return fmt.Errorf("generate uid: %v", err)
+	}
+	if zero != nil && reflect.TypeOf(id) != reflect.TypeOf(zero) {
+		return fmt.Errorf("%w: generated uid %T doesn't match the uid %T of %s", ErrInvalidObject, id, zero, gvk)
+	}
+	m.SetUID(id)
+	return nil
+}
+
+// parseUID converts the uid of metav1.OwnerReference to the Uid type of the target object of Storage
+func parseUID(f Factory, gvk schema.GroupVersionKind, id string) (any, error) {
+	target, err := f.NewObject(gvk)
+	if err != nil {
+		return nil, err
+	}
+	tm, err := meta.Accessor(target)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidObject, err)
+	}
+
+	switch tm.GetUID().(type) {
+	case int64:
+		n, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid uid %q of %s", ErrInvalidObject, id, gvk)
+		}
+		return n, nil
+	default:
+		return id, nil
+	}
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "github.com/vine-io/apimachinery/apis/meta/v1"
+	"github.com/vine-io/apimachinery/uid"
+)
+
+func TestAssignUID(t *testing.T) {
+	ctx := context.TODO()
+	f := NewStorageFactory()
+	if err := f.AddKnownStorages(nil, SchemeGroupVersion, &memStorage{}); err != nil {
+		t.Fatal(err)
+	}
+	gvk := SchemeGroupVersion.WithKind("MemObj")
+	memRows = map[string]*MemObj{}
+
+	create := func(name, uid string) (*MemObj, error) {
+		in := &MemObj{ObjectMeta: metav1.ObjectMeta{Name: name, Uid: uid}}
+		in.SetGroupVersionKind(gvk)
+		out, err := Create(ctx, f, nil, in)
+		if err != nil {
+			return nil, err
+		}
+		return out.(*MemObj), nil
+	}
+
+	// the default generator
+	out, err := create("o1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Uid) != 36 {
+		t.Fatalf("Uid = %q, want uuid", out.Uid)
+	}
+
+	// the uid set by client is kept
+	if out, err = create("o2", "fixed"); err != nil || out.Uid != "fixed" {
+		t.Fatalf("Create() = %v, %v", out, err)
+	}
+
+	// the generator of Factory
+	if err = f.SetUIDGenerator(gvk, uid.GeneratorFunc(func() (any, error) { return "generated", nil })); err != nil {
+		t.Fatal(err)
+	}
+	if out, err = create("o3", ""); err != nil || out.Uid != "generated" {
+		t.Fatalf("Create() = %v, %v", out, err)
+	}
+
+	// the generator doesn't match the Uid type
+	if err = f.SetUIDGenerator(gvk, DefaultIntUIDGenerator); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = create("o4", ""); !errors.Is(err, ErrInvalidObject) {
+		t.Fatalf("expected ErrInvalidObject, got %v", err)
+	}
+
+	if err = f.SetUIDGenerator(SchemeGroupVersion.WithKind("Unknown"), uid.UUIDv7); !errors.Is(err, ErrStorageNotExists) {
+		t.Fatalf("expected ErrStorageNotExists, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package uid
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	nodeBits     = 10
+	sequenceBits = 12
+
+	// MaxNode is the maximum node of Snowflake
+	MaxNode     = 1<<nodeBits - 1
+	maxSequence = 1<<sequenceBits - 1
+)
+
+// Epoch is the start time of Snowflake identifiers (2023-01-01 00:00:00 UTC) in milliseconds
+const Epoch int64 = 1672531200000
+
+var ErrInvalidNode = fmt.Errorf("snowflake node must be in range [0, %d]", MaxNode)
+
+// Snowflake generates the time-ordered int64 identifiers, composed of 41 bits milliseconds
+// since Epoch, 10 bits node and 12 bits sequence.
+type Snowflake struct {
+	mu   sync.Mutex
+	now  func() time.Time
+	node int64
+	// last is the milliseconds of the latest identifier
+	last     int64
+	sequence int64
+}
+
+// NewSnowflake creates Snowflake, the node identifies the generator among the processes
+// sharing the same storage.
+func NewSnowflake(node int64) (*Snowflake, error) {
+	if node < 0 || node > MaxNode {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNode, node)
+	}
+	return &Snowflake{now: time.Now, node: node}, nil
+}
+
+func (s *Snowflake) NewUID() (any, error) {
+	return s.Next(), nil
+}
+
+// Next returns the next identifier. The identifiers keep increasing when the clock goes backwards
+// or the sequence of a millisecond runs out, the millisecond of the latest identifier is advanced
+// instead of waiting for the clock.
+func (s *Snowflake) Next() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ms := s.now().UnixMilli() - Epoch
+	if ms > s.last {
+		s.last = ms
+		s.sequence = 0
+	} else if s.sequence < maxSequence {
+		s.sequence++
+	} else {
+		s.last++
+		s.sequence = 0
+	}
+
+	return s.last<<(nodeBits+sequenceBits) | s.node<<sequenceBits | s.sequence
+}
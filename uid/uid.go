@@ -0,0 +1,63 @@
+// MIT License
+//
+// Copyright (c) 2023 Lack
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package uid generates the unique identifiers of objects, e.g. the Uid of metav1.ObjectMeta (string)
+// and metav1.EntityMeta (int64).
+package uid
+
+import (
+	"github.com/google/uuid"
+)
+
+// Generator generates unique identifiers
+type Generator interface {
+	// NewUID returns a new unique identifier
+	NewUID() (any, error)
+}
+
+// GeneratorFunc is an adapter to allow the use of ordinary functions as Generator
+type GeneratorFunc func() (any, error)
+
+func (f GeneratorFunc) NewUID() (any, error) { return f() }
+
+var (
+	// UUIDv4 generates the random UUID strings (RFC 4122 version 4)
+	UUIDv4 Generator = GeneratorFunc(newUUIDv4)
+	// UUIDv7 generates the time-ordered UUID strings (RFC 9562 version 7)
+	UUIDv7 Generator = GeneratorFunc(newUUIDv7)
+)
+
+func newUUIDv4() (any, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
+
+func newUUIDv7() (any, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
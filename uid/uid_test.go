@@ -0,0 +1,94 @@
+package uid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		name      string
+		generator Generator
+		version   uuid.Version
+	}{
+		{name: "v4", generator: UUIDv4, version: 4},
+		{name: "v7", generator: UUIDv7, version: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := map[any]bool{}
+			for i := 0; i < 100; i++ {
+				v, err := tt.generator.NewUID()
+				if err != nil {
+					t.Fatal(err)
+				}
+				id, err := uuid.Parse(v.(string))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if id.Version() != tt.version {
+					t.Fatalf("version of %s = %d, want %d", id, id.Version(), tt.version)
+				}
+				if seen[v] {
+					t.Fatalf("duplicated uid %s", v)
+				}
+				seen[v] = true
+			}
+		})
+	}
+}
+
+func TestSnowflake(t *testing.T) {
+	if _, err := NewSnowflake(MaxNode + 1); !errors.Is(err, ErrInvalidNode) {
+		t.Fatalf("expected ErrInvalidNode, got %v", err)
+	}
+
+	s, err := NewSnowflake(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.UnixMilli(Epoch + 1000)
+	s.now = func() time.Time { return now }
+
+	first := s.Next()
+	if ms := first >> (nodeBits + sequenceBits); ms != 1000 {
+		t.Fatalf("milliseconds = %d, want 1000", ms)
+	}
+	if node := first >> sequenceBits & MaxNode; node != 3 {
+		t.Fatalf("node = %d, want 3", node)
+	}
+
+	last := first
+	next := func() {
+		id := s.Next()
+		if id <= last {
+			t.Fatalf("uid %d isn't greater than %d", id, last)
+		}
+		last = id
+	}
+
+	// the sequence runs out in the same millisecond
+	for i := 0; i < 2*maxSequence; i++ {
+		next()
+	}
+	// the clock goes backwards
+	now = now.Add(-time.Second)
+	next()
+	// the clock goes forwards
+	now = now.Add(time.Hour)
+	next()
+	if ms := last >> (nodeBits + sequenceBits); ms != now.UnixMilli()-Epoch {
+		t.Fatalf("milliseconds = %d, want %d", ms, now.UnixMilli()-Epoch)
+	}
+
+	v, err := s.NewUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.(int64); !ok {
+		t.Fatalf("NewUID() = %T, want int64", v)
+	}
+}
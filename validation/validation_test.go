@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsDNS1123(t *testing.T) {
+	tests := []struct {
+		value     string
+		label     bool
+		subdomain bool
+	}{
+		{value: "a", label: true, subdomain: true},
+		{value: "my-name-1", label: true, subdomain: true},
+		{value: "example.com", label: false, subdomain: true},
+		{value: "", label: false, subdomain: false},
+		{value: "-a", label: false, subdomain: false},
+		{value: "a-", label: false, subdomain: false},
+		{value: "MyName", label: false, subdomain: false},
+		{value: "a_b", label: false, subdomain: false},
+		{value: strings.Repeat("a", DNS1123LabelMaxLength+1), label: false, subdomain: true},
+		{value: strings.Repeat("a.", DNS1123SubdomainMaxLength/2) + "ab", label: false, subdomain: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if errs := IsDNS1123Label(tt.value); (len(errs) == 0) != tt.label {
+				t.Errorf("IsDNS1123Label(%q) = %v", tt.value, errs)
+			}
+			if errs := IsDNS1123Subdomain(tt.value); (len(errs) == 0) != tt.subdomain {
+				t.Errorf("IsDNS1123Subdomain(%q) = %v", tt.value, errs)
+			}
+		})
+	}
+}
+
+func TestIsQualifiedName(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "app", valid: true},
+		{value: "My.App_1", valid: true},
+		{value: "vine.io/app", valid: true},
+		{value: "", valid: false},
+		{value: "/app", valid: false},
+		{value: "vine.io/", valid: false},
+		{value: "Vine.io/app", valid: false},
+		{value: "a/b/c", valid: false},
+		{value: "-app", valid: false},
+		{value: strings.Repeat("a", 64), valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if errs := IsQualifiedName(tt.value); (len(errs) == 0) != tt.valid {
+				t.Errorf("IsQualifiedName(%q) = %v", tt.value, errs)
+			}
+		})
+	}
+}
+
+func TestIsValidLabelValue(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "", valid: true},
+		{value: "v1.0_beta-1", valid: true},
+		{value: "a b", valid: false},
+		{value: "-a", valid: false},
+		{value: strings.Repeat("a", LabelValueMaxLength+1), valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if errs := IsValidLabelValue(tt.value); (len(errs) == 0) != tt.valid {
+				t.Errorf("IsValidLabelValue(%q) = %v", tt.value, errs)
+			}
+		})
+	}
+}